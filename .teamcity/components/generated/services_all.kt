@@ -24,6 +24,7 @@ val services = mapOf(
     "auditmanager" to ServiceSpec("Audit Manager"),
     "autoscaling" to ServiceSpec("Auto Scaling", vpcLock = true),
     "autoscalingplans" to ServiceSpec("Auto Scaling Plans"),
+    "b2bi" to ServiceSpec("B2B Data Interchange"),
     "backup" to ServiceSpec("Backup"),
     "batch" to ServiceSpec("Batch", vpcLock = true),
     "bcmdataexports" to ServiceSpec("BCM Data Exports", parallelismOverride = 5),
@@ -220,6 +221,7 @@ val services = mapOf(
     "signer" to ServiceSpec("Signer"),
     "simpledb" to ServiceSpec("SDB (SimpleDB)"),
     "sns" to ServiceSpec("SNS (Simple Notification)"),
+    "socialmessaging" to ServiceSpec("End User Messaging Social"),
     "sqs" to ServiceSpec("SQS (Simple Queue)"),
     "ssm" to ServiceSpec("SSM (Systems Manager)", vpcLock = true),
     "ssmcontacts" to ServiceSpec("SSM Contacts"),
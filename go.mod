@@ -39,6 +39,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.37.7
 	github.com/aws/aws-sdk-go-v2/service/autoscaling v1.51.1
 	github.com/aws/aws-sdk-go-v2/service/autoscalingplans v1.24.7
+	github.com/aws/aws-sdk-go-v2/service/b2bi v1.0.0-preview.54
 	github.com/aws/aws-sdk-go-v2/service/backup v1.39.8
 	github.com/aws/aws-sdk-go-v2/service/batch v1.48.2
 	github.com/aws/aws-sdk-go-v2/service/bcmdataexports v1.7.7
@@ -234,6 +235,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/shield v1.29.7
 	github.com/aws/aws-sdk-go-v2/service/signer v1.26.7
 	github.com/aws/aws-sdk-go-v2/service/sns v1.33.7
+	github.com/aws/aws-sdk-go-v2/service/socialmessaging v1.0.0
 	github.com/aws/aws-sdk-go-v2/service/sqs v1.37.2
 	github.com/aws/aws-sdk-go-v2/service/ssm v1.56.1
 	github.com/aws/aws-sdk-go-v2/service/ssmcontacts v1.26.7
@@ -30,6 +30,7 @@ variable "service_labels" {
     "auditmanager",
     "autoscaling",
     "autoscalingplans",
+    "b2bi",
     "backup",
     "backupgateway",
     "batch",
@@ -313,6 +314,7 @@ variable "service_labels" {
     "snowball",
     "snowdevicemanagement",
     "sns",
+    "socialmessaging",
     "sqs",
     "ssm",
     "ssmcontacts",
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// APICall is a sanitized record of a single AWS API call attempt.
+// Request and response bodies and headers are deliberately not recorded.
+type APICall struct {
+	Duration   time.Duration
+	ErrorCode  string
+	Operation  string
+	RequestID  string
+	ServiceID  string
+	StatusCode int
+}
+
+func (c APICall) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s %s: HTTP %d", c.ServiceID, c.Operation, c.StatusCode)
+	if c.RequestID != "" {
+		fmt.Fprintf(&b, ", request ID %s", c.RequestID)
+	}
+	fmt.Fprintf(&b, ", %s", c.Duration.Round(time.Millisecond))
+	if c.ErrorCode != "" {
+		fmt.Fprintf(&b, ", error %s", c.ErrorCode)
+	}
+
+	return b.String()
+}
+
+type apiCallRecorder struct {
+	mu    sync.Mutex
+	calls []APICall
+}
+
+func (r *apiCallRecorder) add(call APICall) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, call)
+}
+
+type apiCallRecorderKey struct{}
+
+// NewAPICallRecorderContext returns a Context that records the AWS API calls made with it.
+// Calls are only recorded if the provider is configured with `api_call_diagnostics`.
+func NewAPICallRecorderContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, apiCallRecorderKey{}, &apiCallRecorder{})
+}
+
+// APICallsFromContext returns the AWS API calls recorded in the Context.
+func APICallsFromContext(ctx context.Context) []APICall {
+	r, ok := ctx.Value(apiCallRecorderKey{}).(*apiCallRecorder)
+	if !ok {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]APICall(nil), r.calls...)
+}
+
+// addAPICallRecorderMiddleware records each API call attempt made with a Context returned by NewAPICallRecorderContext.
+func addAPICallRecorderMiddleware(stack *middleware.Stack) error {
+	// Added before the operation's deserializer so that the service's error code is available.
+	return stack.Deserialize.Add(middleware.DeserializeMiddlewareFunc("TFAPICallRecorder", func(ctx context.Context, in middleware.DeserializeInput, next middleware.DeserializeHandler) (middleware.DeserializeOutput, middleware.Metadata, error) {
+		r, ok := ctx.Value(apiCallRecorderKey{}).(*apiCallRecorder)
+		if !ok {
+			return next.HandleDeserialize(ctx, in)
+		}
+
+		start := time.Now()
+		out, metadata, err := next.HandleDeserialize(ctx, in)
+
+		call := APICall{
+			Duration:  time.Since(start),
+			Operation: awsmiddleware.GetOperationName(ctx),
+			ServiceID: awsmiddleware.GetServiceID(ctx),
+		}
+		if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+			call.RequestID = v
+		}
+		if v, ok := out.RawResponse.(*smithyhttp.Response); ok {
+			call.StatusCode = v.StatusCode
+			if call.RequestID == "" {
+				call.RequestID = v.Header.Get("X-Amzn-Requestid")
+			}
+			if call.RequestID == "" {
+				call.RequestID = v.Header.Get("X-Amz-Request-Id")
+			}
+		}
+		if err != nil {
+			if apiErr, ok := errs.As[smithy.APIError](err); ok {
+				call.ErrorCode = apiErr.ErrorCode()
+			} else {
+				call.ErrorCode = "transport error"
+			}
+		}
+
+		r.add(call)
+
+		return out, metadata, err
+	}), middleware.Before)
+}
+
+// NewAPICallsDiagnosticContext returns a Context that records the AWS API calls made by a resource operation
+// when the provider is configured with `api_call_diagnostics`. Otherwise ctx is returned unchanged.
+// The SDKv2 and Plugin Framework resource interceptors call it before each Create, Read, Update and Delete,
+// and APICallsDiagnosticFromContext after it. Data sources, plan modification and import are not covered.
+func NewAPICallsDiagnosticContext(ctx context.Context, c *AWSClient) context.Context {
+	if c == nil || !c.APICallDiagnostics(ctx) {
+		return ctx
+	}
+
+	return NewAPICallRecorderContext(ctx)
+}
+
+// APICallsDiagnosticFromContext returns the summary and detail of a warning diagnostic reporting the AWS API calls
+// recorded in a Context returned by NewAPICallsDiagnosticContext, and whether any calls were recorded.
+func APICallsDiagnosticFromContext(ctx context.Context, operation string) (string, string, bool) {
+	calls := APICallsFromContext(ctx)
+	if len(calls) == 0 {
+		return "", "", false
+	}
+
+	summary, detail := apiCallsDiagnostic(ctx, operation, calls)
+
+	return summary, detail, true
+}
+
+// apiCallsDiagnostic returns the summary and detail of a warning diagnostic reporting the AWS API calls made by a resource operation.
+func apiCallsDiagnostic(ctx context.Context, operation string, calls []APICall) (string, string) {
+	resourceName := "resource"
+	if v, ok := FromContext(ctx); ok && v.ResourceName != "" {
+		resourceName = v.ResourceName
+	}
+
+	lines := make([]string, len(calls))
+	for i, call := range calls {
+		lines[i] = call.String()
+	}
+
+	return fmt.Sprintf("AWS API calls made by %s %s", resourceName, operation), strings.Join(lines, "\n")
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestAPICallRecorderMiddleware(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := codedeploy.New(codedeploy.Options{
+		APIOptions:       []func(*middleware.Stack) error{addAPICallRecorderMiddleware},
+		Credentials:      aws.AnonymousCredentials{},
+		Region:           "us-west-2", //lintignore:AWSAT003
+		RetryMaxAttempts: 1,
+		HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+			var statusCode int
+			var body string
+
+			if strings.HasSuffix(r.Header.Get("X-Amz-Target"), ".GetApplication") {
+				statusCode, body = http.StatusBadRequest, `{"__type":"ApplicationDoesNotExistException","message":"No application found"}`
+			} else {
+				statusCode, body = http.StatusOK, `{"applications":[]}`
+			}
+
+			return &http.Response{
+				StatusCode: statusCode,
+				Header:     http.Header{"X-Amzn-Requestid": []string{"request-1"}},
+				Body:       io.NopCloser(strings.NewReader(body)),
+			}, nil
+		}),
+	})
+
+	if _, err := client.ListApplications(ctx, &codedeploy.ListApplicationsInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if calls := APICallsFromContext(ctx); calls != nil {
+		t.Errorf("expected no calls recorded without a recorder, got %v", calls)
+	}
+
+	ctx = NewAPICallRecorderContext(ctx)
+
+	if _, err := client.ListApplications(ctx, &codedeploy.ListApplicationsInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if _, err := client.GetApplication(ctx, &codedeploy.GetApplicationInput{ApplicationName: aws.String("missing")}); err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	calls := APICallsFromContext(ctx)
+	for i := range calls {
+		calls[i].Duration = 0
+	}
+
+	want := []APICall{
+		{
+			Operation:  "ListApplications",
+			RequestID:  "request-1",
+			ServiceID:  "CodeDeploy",
+			StatusCode: http.StatusOK,
+		},
+		{
+			ErrorCode:  "ApplicationDoesNotExistException",
+			Operation:  "GetApplication",
+			RequestID:  "request-1",
+			ServiceID:  "CodeDeploy",
+			StatusCode: http.StatusBadRequest,
+		},
+	}
+	if len(calls) != len(want) {
+		t.Fatalf("got %d calls, want %d: %v", len(calls), len(want), calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("call %d = %+v, want %+v", i, calls[i], want[i])
+		}
+	}
+}
+
+func TestAPICallString(t *testing.T) {
+	t.Parallel()
+
+	call := APICall{
+		Duration:   1500 * time.Microsecond,
+		ErrorCode:  "ThrottlingException",
+		Operation:  "GetApplication",
+		RequestID:  "request-1",
+		ServiceID:  "CodeDeploy",
+		StatusCode: http.StatusBadRequest,
+	}
+
+	if got, want := call.String(), "CodeDeploy GetApplication: HTTP 400, request ID request-1, 2ms, error ThrottlingException"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestAPICallsDiagnosticContext(t *testing.T) {
+	t.Parallel()
+
+	call := APICall{
+		Duration:   time.Millisecond,
+		Operation:  "GetApplication",
+		RequestID:  "request-1",
+		ServiceID:  "CodeDeploy",
+		StatusCode: http.StatusOK,
+	}
+
+	testCases := map[string]struct {
+		client          *AWSClient
+		calls           []APICall
+		expectedSummary string
+		expectedDetail  string
+	}{
+		"no client": {
+			calls: []APICall{call},
+		},
+		"disabled": {
+			client: &AWSClient{},
+			calls:  []APICall{call},
+		},
+		"no calls": {
+			client: &AWSClient{apiCallDiagnostics: true},
+		},
+		"calls": {
+			client:          &AWSClient{apiCallDiagnostics: true},
+			calls:           []APICall{call, call},
+			expectedSummary: "AWS API calls made by Application Read",
+			expectedDetail:  "CodeDeploy GetApplication: HTTP 200, request ID request-1, 1ms\nCodeDeploy GetApplication: HTTP 200, request ID request-1, 1ms",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewResourceContext(context.Background(), "deploy", "Application")
+			ctx = NewAPICallsDiagnosticContext(ctx, testCase.client)
+
+			if r, ok := ctx.Value(apiCallRecorderKey{}).(*apiCallRecorder); ok {
+				for _, call := range testCase.calls {
+					r.add(call)
+				}
+			}
+
+			summary, detail, ok := APICallsDiagnosticFromContext(ctx, "Read")
+
+			if got, want := ok, testCase.expectedSummary != ""; got != want {
+				t.Fatalf("ok = %t, want %t", got, want)
+			}
+			if summary != testCase.expectedSummary {
+				t.Errorf("summary = %q, want %q", summary, testCase.expectedSummary)
+			}
+			if detail != testCase.expectedDetail {
+				t.Errorf("detail = %q, want %q", detail, testCase.expectedDetail)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package conns
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
@@ -27,3 +29,49 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// AddAdaptiveRateLimiting returns a Retryer which adds the adaptive retry mode's client-side attempt rate limiting to the specified Retryer.
+// The attempt rate is reduced when attempts fail with throttling errors and is restored as attempts succeed.
+func AddAdaptiveRateLimiting(r aws.RetryerV2) aws.RetryerV2 {
+	return &withAdaptiveRateLimiting{
+		RetryerV2: r,
+		adaptive:  retry.NewAdaptiveMode(),
+	}
+}
+
+type withAdaptiveRateLimiting struct {
+	aws.RetryerV2
+	adaptive *retry.AdaptiveMode
+}
+
+func (r *withAdaptiveRateLimiting) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	releaseRateLimitToken, err := r.adaptive.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	releaseToken, err := r.RetryerV2.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(err error) error {
+		_ = releaseRateLimitToken(err)
+		return releaseToken(err)
+	}, nil
+}
+
+// RemoveAdaptiveRateLimiting returns a Retryer which removes the adaptive retry mode's client-side attempt rate limiting from the specified Retryer.
+func RemoveAdaptiveRateLimiting(r aws.RetryerV2) aws.RetryerV2 {
+	return &withoutAdaptiveRateLimiting{
+		RetryerV2: r,
+	}
+}
+
+type withoutAdaptiveRateLimiting struct {
+	aws.RetryerV2
+}
+
+func (r *withoutAdaptiveRateLimiting) GetAttemptToken(context.Context) (func(error) error, error) {
+	return r.RetryerV2.GetInitialToken(), nil //nolint:staticcheck // Same as the standard retry mode's GetAttemptToken.
+}
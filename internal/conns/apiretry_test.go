@@ -4,6 +4,7 @@
 package conns
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
@@ -93,3 +94,47 @@ func TestAddIsErrorRetryables(t *testing.T) {
 		})
 	}
 }
+
+func TestAddAdaptiveRateLimiting(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := AddAdaptiveRateLimiting(retry.AddWithMaxAttempts(retry.NewStandard(), 50).(aws.RetryerV2))
+
+	if got, want := r.MaxAttempts(), 50; got != want {
+		t.Errorf("MaxAttempts() = %v, want %v", got, want)
+	}
+
+	for range 10 {
+		releaseToken, err := r.GetAttemptToken(ctx)
+		if err != nil {
+			t.Fatalf("GetAttemptToken() returned error: %s", err)
+		}
+
+		if err := releaseToken(nil); err != nil {
+			t.Fatalf("releasing attempt token returned error: %s", err)
+		}
+	}
+}
+
+func TestRemoveAdaptiveRateLimiting(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := RemoveAdaptiveRateLimiting(retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+		o.FailOnNoAttemptTokens = true
+	}))
+
+	// Throttling errors would otherwise enable the adaptive retry mode's client-side attempt rate limiting.
+	throttlingErr := &smithy.GenericAPIError{Code: "ThrottlingException"}
+	for range 100 {
+		releaseToken, err := r.GetAttemptToken(ctx)
+		if err != nil {
+			t.Fatalf("GetAttemptToken() returned error: %s", err)
+		}
+
+		if err := releaseToken(throttlingErr); err != nil {
+			t.Fatalf("releasing attempt token returned error: %s", err)
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
@@ -29,6 +30,7 @@ type AWSClient struct {
 	ServicePackages map[string]ServicePackage
 
 	accountID                 string
+	apiCallDiagnostics        bool // From provider configuration.
 	awsConfig                 *aws.Config
 	clients                   map[string]any
 	conns                     map[string]any
@@ -38,8 +40,10 @@ type AWSClient struct {
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	maxRetriesPerService      map[string]int // From provider configuration.
 	partition                 endpoints.Partition
 	region                    string
+	retryModePerService       map[string]aws.RetryMode // From provider configuration.
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3.Client
 	s3UsePathStyle            bool   // From provider configuration.
@@ -63,6 +67,11 @@ func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
 
+// APICallDiagnostics returns whether resource operations should report the AWS API calls they make as warnings.
+func (c *AWSClient) APICallDiagnostics(context.Context) bool {
+	return c.apiCallDiagnostics
+}
+
 func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
 	return c.awsConfig.Copy()
 }
@@ -292,7 +301,7 @@ func convertIPToDashIP(ip string) string {
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": c.apiClientAWSConfig(servicePackageName),
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 	}
@@ -312,6 +321,56 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 	return m
 }
 
+// apiClientAWSConfig returns the AWS SDK for Go v2 configuration for the specified service.
+// Any per-service retry configuration overrides the provider-level retry configuration.
+func (c *AWSClient) apiClientAWSConfig(servicePackageName string) *aws.Config {
+	maxRetries, okMaxRetries := c.maxRetriesPerService[servicePackageName]
+	retryMode, okRetryMode := c.retryModePerService[servicePackageName]
+
+	if !okMaxRetries && !okRetryMode {
+		return c.awsConfig
+	}
+
+	cfg := c.awsConfig.Copy()
+	baseRetryMode := cfg.RetryMode
+	if baseRetryMode == "" {
+		baseRetryMode = aws.RetryModeStandard
+	}
+	baseRetryer := cfg.Retryer
+	if baseRetryer == nil {
+		baseRetryer = func() aws.Retryer {
+			return retry.NewStandard()
+		}
+	}
+
+	cfg.Retryer = func() aws.Retryer {
+		r, ok := baseRetryer().(aws.RetryerV2)
+		if !ok {
+			r = retry.NewStandard()
+		}
+
+		if okMaxRetries {
+			r = retry.AddWithMaxAttempts(r, maxRetries).(aws.RetryerV2)
+		}
+
+		if okRetryMode && retryMode != baseRetryMode {
+			switch retryMode {
+			case aws.RetryModeAdaptive:
+				r = AddAdaptiveRateLimiting(r)
+			case aws.RetryModeStandard:
+				r = RemoveAdaptiveRateLimiting(r)
+			}
+		}
+
+		return r
+	}
+	if okRetryMode {
+		cfg.RetryMode = retryMode
+	}
+
+	return &cfg
+}
+
 // client returns the AWS SDK for Go v2 API client for the specified service.
 // The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
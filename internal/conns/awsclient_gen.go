@@ -27,6 +27,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/autoscalingplans"
+	"github.com/aws/aws-sdk-go-v2/service/b2bi"
 	"github.com/aws/aws-sdk-go-v2/service/backup"
 	"github.com/aws/aws-sdk-go-v2/service/batch"
 	"github.com/aws/aws-sdk-go-v2/service/bcmdataexports"
@@ -222,6 +223,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/shield"
 	"github.com/aws/aws-sdk-go-v2/service/signer"
 	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/socialmessaging"
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts"
@@ -345,6 +347,10 @@ func (c *AWSClient) AutoScalingPlansClient(ctx context.Context) *autoscalingplan
 	return errs.Must(client[*autoscalingplans.Client](ctx, c, names.AutoScalingPlans, make(map[string]any)))
 }
 
+func (c *AWSClient) B2BIClient(ctx context.Context) *b2bi.Client {
+	return errs.Must(client[*b2bi.Client](ctx, c, names.B2BI, make(map[string]any)))
+}
+
 func (c *AWSClient) BCMDataExportsClient(ctx context.Context) *bcmdataexports.Client {
 	return errs.Must(client[*bcmdataexports.Client](ctx, c, names.BCMDataExports, make(map[string]any)))
 }
@@ -1165,6 +1171,10 @@ func (c *AWSClient) SignerClient(ctx context.Context) *signer.Client {
 	return errs.Must(client[*signer.Client](ctx, c, names.Signer, make(map[string]any)))
 }
 
+func (c *AWSClient) SocialMessagingClient(ctx context.Context) *socialmessaging.Client {
+	return errs.Must(client[*socialmessaging.Client](ctx, c, names.SocialMessaging, make(map[string]any)))
+}
+
 func (c *AWSClient) StorageGatewayClient(ctx context.Context) *storagegateway.Client {
 	return errs.Must(client[*storagegateway.Client](ctx, c, names.StorageGateway, make(map[string]any)))
 }
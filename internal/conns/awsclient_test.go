@@ -7,7 +7,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var (
@@ -230,3 +233,72 @@ func TestReverseDNS(t *testing.T) {
 		})
 	}
 }
+
+func TestAWSClientAPIClientAWSConfig(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		awsConfig: &aws.Config{
+			Retryer: func() aws.Retryer {
+				return retry.NewStandard(func(o *retry.StandardOptions) {
+					o.MaxAttempts = 25
+				})
+			},
+			RetryMode: aws.RetryModeStandard,
+		},
+		maxRetriesPerService: map[string]int{
+			names.EC2: 50,
+		},
+		retryModePerService: map[string]aws.RetryMode{
+			names.EC2: aws.RetryModeAdaptive,
+			names.IAM: aws.RetryModeAdaptive,
+		},
+	}
+
+	testCases := []struct {
+		ServicePackageName  string
+		ExpectedMaxAttempts int
+		ExpectedRetryMode   aws.RetryMode
+		ExpectedAdaptive    bool
+	}{
+		{
+			ServicePackageName:  names.EC2,
+			ExpectedMaxAttempts: 50,
+			ExpectedRetryMode:   aws.RetryModeAdaptive,
+			ExpectedAdaptive:    true,
+		},
+		{
+			ServicePackageName:  names.IAM,
+			ExpectedMaxAttempts: 25,
+			ExpectedRetryMode:   aws.RetryModeAdaptive,
+			ExpectedAdaptive:    true,
+		},
+		{
+			ServicePackageName:  names.S3,
+			ExpectedMaxAttempts: 25,
+			ExpectedRetryMode:   aws.RetryModeStandard,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.ServicePackageName, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := client.apiClientAWSConfig(testCase.ServicePackageName)
+
+			if got, want := cfg.RetryMode, testCase.ExpectedRetryMode; got != want {
+				t.Errorf("RetryMode = %v, want %v", got, want)
+			}
+
+			r := cfg.Retryer()
+
+			if got, want := r.MaxAttempts(), testCase.ExpectedMaxAttempts; got != want {
+				t.Errorf("MaxAttempts() = %v, want %v", got, want)
+			}
+
+			if _, got := r.(*withAdaptiveRateLimiting); got != testCase.ExpectedAdaptive {
+				t.Errorf("adaptive rate limiting = %v, want %v", got, testCase.ExpectedAdaptive)
+			}
+		})
+	}
+}
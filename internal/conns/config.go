@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	awsbasev1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2"
@@ -29,6 +30,7 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	APICallDiagnostics             bool
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
@@ -43,10 +45,13 @@ type Config struct {
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	MaxRetriesPerService           map[string]int
 	NoProxy                        string
 	Profile                        string
 	Region                         string
 	RetryMode                      aws.RetryMode
+	RetryModePerService            map[string]aws.RetryMode
+	RolesAnywhere                  *RolesAnywhere
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
@@ -136,6 +141,33 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	var rolesAnywhereCredentials aws.CredentialsProvider
+	if c.RolesAnywhere != nil {
+		tflog.Debug(ctx, "Retrieving credentials using IAM Roles Anywhere")
+		rolesAnywhere := *c.RolesAnywhere
+		rolesAnywhere.Endpoint = c.Endpoints[names.RolesAnywhere]
+		// Use an HTTP client with the same proxy, CA bundle and TLS settings as the one built for AWS API calls.
+		transportOptions, err := awsbaseConfig.HTTPTransportOptions()
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring IAM Roles Anywhere: %s", err)
+		}
+		httpClient := awshttp.NewBuildableClient().WithTransportOptions(transportOptions)
+		provider, err := newRolesAnywhereCredentialsProvider(rolesAnywhere, httpClient)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring IAM Roles Anywhere: %s", err)
+		}
+
+		credentials, err := provider.Retrieve(ctx)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "retrieving credentials using IAM Roles Anywhere: %s", err)
+		}
+
+		awsbaseConfig.AccessKey = credentials.AccessKeyID
+		awsbaseConfig.SecretKey = credentials.SecretAccessKey
+		awsbaseConfig.Token = credentials.SessionToken
+		rolesAnywhereCredentials = provider
+	}
+
 	// Avoid duplicate calls to STS by enabling SkipCredsValidation for the call to GetAwsConfig
 	// and then restoring the configured value for the call to GetAwsAccountIDAndPartition.
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
@@ -156,6 +188,17 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// The IAM Roles Anywhere credentials are refreshed when they expire unless they are only used to assume a role.
+	// awsbase only accepts static source credentials for assume_role, so role sessions can't be renewed after the
+	// Roles Anywhere session ends.
+	if rolesAnywhereCredentials != nil && len(c.AssumeRole) == 0 && c.AssumeRoleWithWebIdentity == nil {
+		cfg.Credentials = aws.NewCredentialsCache(rolesAnywhereCredentials)
+	}
+
+	if c.APICallDiagnostics {
+		cfg.APIOptions = append(cfg.APIOptions, addAPICallRecorderMiddleware)
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -208,6 +251,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.accountID = accountID
+	client.apiCallDiagnostics = c.APICallDiagnostics
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.region = c.Region
@@ -220,6 +264,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
+	client.maxRetriesPerService = c.MaxRetriesPerService
+	client.retryModePerService = c.RetryModePerService
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
@@ -0,0 +1,362 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	rolesAnywhereCredentialsSource = "RolesAnywhereCredentialsProvider"
+	rolesAnywhereDefaultDuration   = 1 * time.Hour
+	rolesAnywhereSigningName       = "rolesanywhere"
+)
+
+// RolesAnywhere configures IAM Roles Anywhere as the source of the provider's credentials.
+// See https://docs.aws.amazon.com/rolesanywhere/latest/userguide/authentication.html.
+type RolesAnywhere struct {
+	CertificateChainFile string
+	CertificateFile      string
+	Duration             time.Duration
+	Endpoint             string
+	PrivateKeyFile       string
+	ProfileARN           string
+	RoleARN              string
+	SessionName          string
+	TrustAnchorARN       string
+}
+
+// rolesAnywhereCredentialsProvider is an aws.CredentialsProvider that obtains temporary credentials
+// from the IAM Roles Anywhere CreateSession API, in the same way as the AWS credential helper.
+// Requests are signed with the private key of an X.509 end-entity certificate.
+// See https://docs.aws.amazon.com/rolesanywhere/latest/userguide/authentication-sign-process.html.
+type rolesAnywhereCredentialsProvider struct {
+	certificate      *x509.Certificate
+	certificateChain []*x509.Certificate
+	config           RolesAnywhere
+	endpoint         string
+	httpClient       aws.HTTPClient
+	region           string
+	signer           crypto.Signer
+}
+
+var _ aws.CredentialsProvider = (*rolesAnywhereCredentialsProvider)(nil)
+
+func newRolesAnywhereCredentialsProvider(config RolesAnywhere, httpClient aws.HTTPClient) (*rolesAnywhereCredentialsProvider, error) {
+	trustAnchorARN, err := arn.Parse(config.TrustAnchorARN)
+	if err != nil {
+		return nil, fmt.Errorf("parsing IAM Roles Anywhere trust anchor ARN (%s): %w", config.TrustAnchorARN, err)
+	}
+
+	certificates, err := readCertificatesFile(config.CertificateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var certificateChain []*x509.Certificate
+	if config.CertificateChainFile != "" {
+		certificateChain, err = readCertificatesFile(config.CertificateChainFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	signer, err := readPrivateKeyFile(config.PrivateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	region := trustAnchorARN.Region
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://%s.%s.%s", rolesAnywhereSigningName, region, names.PartitionForRegion(region).DNSSuffix())
+	}
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &rolesAnywhereCredentialsProvider{
+		certificate:      certificates[0],
+		certificateChain: certificateChain,
+		config:           config,
+		endpoint:         strings.TrimSuffix(endpoint, "/"),
+		httpClient:       httpClient,
+		region:           region,
+		signer:           signer,
+	}, nil
+}
+
+type rolesAnywhereCreateSessionInput struct {
+	DurationSeconds int32  `json:"durationSeconds"`
+	ProfileARN      string `json:"profileArn"`
+	RoleARN         string `json:"roleArn"`
+	RoleSessionName string `json:"roleSessionName,omitempty"`
+	TrustAnchorARN  string `json:"trustAnchorArn"`
+}
+
+type rolesAnywhereCreateSessionOutput struct {
+	CredentialSet []struct {
+		Credentials struct {
+			AccessKeyID     string `json:"accessKeyId"`
+			Expiration      string `json:"expiration"`
+			SecretAccessKey string `json:"secretAccessKey"`
+			SessionToken    string `json:"sessionToken"`
+		} `json:"credentials"`
+	} `json:"credentialSet"`
+	Message string `json:"message"`
+}
+
+// Retrieve calls CreateSession and returns the resulting temporary credentials.
+func (p *rolesAnywhereCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	duration := p.config.Duration
+	if duration == 0 {
+		duration = rolesAnywhereDefaultDuration
+	}
+
+	payload, err := json.Marshal(rolesAnywhereCreateSessionInput{
+		DurationSeconds: int32(duration.Seconds()),
+		ProfileARN:      p.config.ProfileARN,
+		RoleARN:         p.config.RoleARN,
+		RoleSessionName: p.config.SessionName,
+		TrustAnchorARN:  p.config.TrustAnchorARN,
+	})
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/sessions", bytes.NewReader(payload))
+	if err != nil {
+		return aws.Credentials{}, err
+	}
+
+	if err := p.sign(req, payload, time.Now().UTC()); err != nil {
+		return aws.Credentials{}, fmt.Errorf("signing IAM Roles Anywhere CreateSession request: %w", err)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("calling IAM Roles Anywhere CreateSession: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("reading IAM Roles Anywhere CreateSession response: %w", err)
+	}
+
+	var output rolesAnywhereCreateSessionOutput
+	if err := json.Unmarshal(body, &output); err != nil && resp.StatusCode < http.StatusMultipleChoices {
+		return aws.Credentials{}, fmt.Errorf("decoding IAM Roles Anywhere CreateSession response: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		message := output.Message
+		if message == "" {
+			message = strings.TrimSpace(string(body))
+		}
+		return aws.Credentials{}, fmt.Errorf("calling IAM Roles Anywhere CreateSession: HTTP %d: %s", resp.StatusCode, message)
+	}
+
+	if len(output.CredentialSet) == 0 {
+		return aws.Credentials{}, errors.New("IAM Roles Anywhere CreateSession returned no credentials")
+	}
+
+	credentials := output.CredentialSet[0].Credentials
+	expires, err := time.Parse(time.RFC3339, credentials.Expiration)
+	if err != nil {
+		return aws.Credentials{}, fmt.Errorf("parsing IAM Roles Anywhere credentials expiration (%s): %w", credentials.Expiration, err)
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     credentials.AccessKeyID,
+		SecretAccessKey: credentials.SecretAccessKey,
+		SessionToken:    credentials.SessionToken,
+		Source:          rolesAnywhereCredentialsSource,
+		CanExpire:       true,
+		Expires:         expires,
+	}, nil
+}
+
+// sign adds the X.509 Signature Version 4 headers to the request.
+func (p *rolesAnywhereCredentialsProvider) sign(req *http.Request, payload []byte, t time.Time) error {
+	var algorithm string
+	switch p.signer.Public().(type) {
+	case *rsa.PublicKey:
+		algorithm = "AWS4-X509-RSA-SHA256"
+	case *ecdsa.PublicKey:
+		algorithm = "AWS4-X509-ECDSA-SHA256"
+	default:
+		return fmt.Errorf("unsupported private key type: %T", p.signer.Public())
+	}
+
+	amzDate := t.Format("20060102T150405Z")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-X509", base64.StdEncoding.EncodeToString(p.certificate.Raw))
+	if len(p.certificateChain) > 0 {
+		chain := make([]string, len(p.certificateChain))
+		for i, v := range p.certificateChain {
+			chain[i] = base64.StdEncoding.EncodeToString(v.Raw)
+		}
+		req.Header.Set("X-Amz-X509-Chain", strings.Join(chain, ","))
+	}
+
+	credentialScope := strings.Join([]string{t.Format("20060102"), p.region, rolesAnywhereSigningName, "aws4_request"}, "/")
+	signedHeaders, stringToSign := rolesAnywhereStringToSign(req, payload, algorithm, amzDate, credentialScope)
+
+	digest := sha256.Sum256([]byte(stringToSign))
+	signature, err := p.signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s", algorithm, p.certificate.SerialNumber.String(), credentialScope, signedHeaders, hex.EncodeToString(signature)))
+
+	return nil
+}
+
+// rolesAnywhereStringToSign returns the signed headers list and the string to sign for the request.
+func rolesAnywhereStringToSign(req *http.Request, payload []byte, algorithm, amzDate, credentialScope string) (string, string) {
+	headers := []string{"content-type", "host", "x-amz-date", "x-amz-x509"}
+	if req.Header.Get("X-Amz-X509-Chain") != "" {
+		headers = append(headers, "x-amz-x509-chain")
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, v := range headers {
+		value := req.Header.Get(v)
+		if v == "host" {
+			value = req.Host
+			if value == "" {
+				value = req.URL.Host
+			}
+		}
+		fmt.Fprintf(&canonicalHeaders, "%s:%s\n", v, strings.TrimSpace(value))
+	}
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	signedHeaders := strings.Join(headers, ";")
+	payloadHash := sha256.Sum256(payload)
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		hex.EncodeToString(payloadHash[:]),
+	}, "\n")
+	canonicalRequestHash := sha256.Sum256([]byte(canonicalRequest))
+
+	return signedHeaders, strings.Join([]string{
+		algorithm,
+		amzDate,
+		credentialScope,
+		hex.EncodeToString(canonicalRequestHash[:]),
+	}, "\n")
+}
+
+// readCertificatesFile reads the PEM or DER encoded X.509 certificates from the specified file.
+func readCertificatesFile(filename string) ([]*x509.Certificate, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading certificate file (%s): %w", filename, err)
+	}
+
+	var certificates []*x509.Certificate
+	for rest := b; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate file (%s): %w", filename, err)
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	if len(certificates) == 0 {
+		certificates, err = x509.ParseCertificates(b)
+		if err != nil {
+			return nil, fmt.Errorf("parsing certificate file (%s): %w", filename, err)
+		}
+	}
+
+	if len(certificates) == 0 {
+		return nil, fmt.Errorf("certificate file (%s) contains no certificates", filename)
+	}
+
+	return certificates, nil
+}
+
+// readPrivateKeyFile reads an unencrypted PEM encoded RSA or EC private key from the specified file.
+func readPrivateKeyFile(filename string) (crypto.Signer, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading private key file (%s): %w", filename, err)
+	}
+
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("private key file (%s) contains no PEM data", filename)
+	}
+
+	var key any
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("private key file (%s): unsupported PEM block type %q", filename, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing private key file (%s): %w", filename, err)
+	}
+
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		return key, nil
+	case *ecdsa.PrivateKey:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("private key file (%s): unsupported private key type %T", filename, key)
+	}
+}
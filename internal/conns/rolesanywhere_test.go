@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+func TestRolesAnywhereCredentialsProvider(t *testing.T) {
+	t.Parallel()
+
+	const (
+		profileARN     = "arn:aws:rolesanywhere:us-west-2:123456789012:profile/11111111-1111-1111-1111-111111111111"      //lintignore:AWSAT003,AWSAT005
+		roleARN        = "arn:aws:iam::123456789012:role/test"                                                            //lintignore:AWSAT005
+		trustAnchorARN = "arn:aws:rolesanywhere:us-west-2:123456789012:trust-anchor/22222222-2222-2222-2222-222222222222" //lintignore:AWSAT003,AWSAT005
+	)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(123456789),
+		Subject:      pkix.Name{CommonName: "test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dir := t.TempDir()
+	certificateFile := filepath.Join(dir, "certificate.pem")
+	if err := os.WriteFile(certificateFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	privateKeyFile := filepath.Join(dir, "private_key.pem")
+	if err := os.WriteFile(privateKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload, _ := io.ReadAll(r.Body)
+
+		var input rolesAnywhereCreateSessionInput
+		if err := json.Unmarshal(payload, &input); err != nil || r.URL.Path != "/sessions" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if input.DurationSeconds != 900 || input.ProfileARN != profileARN || input.RoleARN != roleARN || input.RoleSessionName != "test-session" || input.TrustAnchorARN != trustAnchorARN {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		// Verify the signature using the end-entity certificate's public key.
+		algorithm, fields, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+		var credential, signedHeaders, signature string
+		for _, v := range strings.Split(fields, ", ") {
+			k, v, _ := strings.Cut(v, "=")
+			switch k {
+			case "Credential":
+				credential = v
+			case "SignedHeaders":
+				signedHeaders = v
+			case "Signature":
+				signature = v
+			}
+		}
+		serialNumber, credentialScope, _ := strings.Cut(credential, "/")
+		if algorithm != "AWS4-X509-ECDSA-SHA256" || serialNumber != "123456789" || !strings.HasSuffix(credentialScope, "/us-west-2/rolesanywhere/aws4_request") { //lintignore:AWSAT003
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		gotSignedHeaders, stringToSign := rolesAnywhereStringToSign(r, payload, algorithm, r.Header.Get("X-Amz-Date"), credentialScope)
+		digest := sha256.Sum256([]byte(stringToSign))
+		sig, _ := hex.DecodeString(signature)
+		if gotSignedHeaders != signedHeaders || !ecdsa.VerifyASN1(&key.PublicKey, digest[:], sig) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"invalid signature"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"credentialSet":[{"credentials":{"accessKeyId":"AKID","expiration":"2030-01-02T03:04:05Z","secretAccessKey":"SECRET","sessionToken":"TOKEN"}}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	testCases := map[string]struct {
+		privateKeyFile string
+		roleARN        string
+		expectedErr    string
+	}{
+		"valid": {
+			privateKeyFile: privateKeyFile,
+			roleARN:        roleARN,
+		},
+		"request rejected": {
+			privateKeyFile: privateKeyFile,
+			roleARN:        "arn:aws:iam::123456789012:role/other", //lintignore:AWSAT005
+			expectedErr:    "HTTP 400",
+		},
+		"missing private key": {
+			privateKeyFile: filepath.Join(dir, "missing.pem"),
+			roleARN:        roleARN,
+			expectedErr:    "reading private key file",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			provider, err := newRolesAnywhereCredentialsProvider(RolesAnywhere{
+				CertificateFile: certificateFile,
+				Duration:        15 * time.Minute,
+				Endpoint:        server.URL,
+				PrivateKeyFile:  testCase.privateKeyFile,
+				ProfileARN:      profileARN,
+				RoleARN:         testCase.roleARN,
+				SessionName:     "test-session",
+				TrustAnchorARN:  trustAnchorARN,
+			}, server.Client())
+
+			if err == nil {
+				var credentials aws.Credentials
+				credentials, err = provider.Retrieve(context.Background())
+				if err == nil {
+					if got, want := credentials.AccessKeyID, "AKID"; got != want {
+						t.Errorf("AccessKeyID = %q, want %q", got, want)
+					}
+					if got, want := credentials.SessionToken, "TOKEN"; got != want {
+						t.Errorf("SessionToken = %q, want %q", got, want)
+					}
+					if got, want := credentials.Expires, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC); !got.Equal(want) {
+						t.Errorf("Expires = %s, want %s", got, want)
+					}
+				}
+			}
+
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), testCase.expectedErr) {
+				t.Fatalf("expected error containing %q, got %v", testCase.expectedErr, err)
+			}
+		})
+	}
+}
@@ -4,9 +4,23 @@
 package conns
 
 import (
+	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
+// SetAccountID is only intended for use in tests
+func SetAccountID(client *AWSClient, accountID string) {
+	client.accountID = accountID
+}
+
+// SetClient is only intended for use in tests
+func SetClient(client *AWSClient, servicePackageName string, apiClient any) {
+	if client.clients == nil {
+		client.clients = make(map[string]any)
+	}
+	client.clients[servicePackageName] = apiClient
+}
+
 // SetDefaultTagsConfig is only intended for use in tests
 func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 	client.defaultTagsConfig = d
@@ -16,3 +30,9 @@ func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 func SetIgnoreTagsConfig(client *AWSClient, i *tftags.IgnoreConfig) {
 	client.ignoreTagsConfig = i
 }
+
+// SetRegion is only intended for use in tests
+func SetRegion(client *AWSClient, region string) {
+	client.region = region
+	client.partition, _ = endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+}
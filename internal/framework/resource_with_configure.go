@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -49,6 +50,13 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	if !planTags.IsUnknown() {
 		if !mapHasUnknownElements(planTags) {
 			resourceTags := tftags.New(ctx, planTags)
+
+			// Enforce the provider-level tag policy at plan time.
+			if err := defaultTagsConfig.ValidateTags(defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tag policy violation", fmt.Sprintf("%s do not satisfy the provider's default_tags policy: %s", names.AttrTags, err))
+				return
+			}
+
 			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+// apiCallsResourceInterceptor reports the AWS API calls made by SDKv2 resource operations.
+// See conns.NewAPICallsDiagnosticContext.
+type apiCallsResourceInterceptor struct{}
+
+func (r apiCallsResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		c, _ := meta.(*conns.AWSClient)
+		ctx = conns.NewAPICallsDiagnosticContext(ctx, c)
+	case Finally:
+		if summary, detail, ok := conns.APICallsDiagnosticFromContext(ctx, apiCallsOperation(why)); ok {
+			diags = append(diags, errs.NewWarningDiagnostic(summary, detail))
+		}
+	}
+
+	return ctx, diags
+}
+
+func apiCallsOperation(why why) string {
+	switch why {
+	case Create:
+		return "Create"
+	case Read:
+		return "Read"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return "operation"
+	}
+}
@@ -835,3 +835,36 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// apiCallsResourceInterceptor reports the AWS API calls made by Plugin Framework resource operations.
+// See conns.NewAPICallsDiagnosticContext.
+type apiCallsResourceInterceptor struct{}
+
+func (r apiCallsResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, "Create", diags)
+}
+
+func (r apiCallsResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, "Read", diags)
+}
+
+func (r apiCallsResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, "Update", diags)
+}
+
+func (r apiCallsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return r.run(ctx, meta, when, "Delete", diags)
+}
+
+func (r apiCallsResourceInterceptor) run(ctx context.Context, meta *conns.AWSClient, when when, operation string, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	switch when {
+	case Before:
+		ctx = conns.NewAPICallsDiagnosticContext(ctx, meta)
+	case Finally:
+		if summary, detail, ok := conns.APICallsDiagnosticFromContext(ctx, operation); ok {
+			diags.AddWarning(summary, detail)
+		}
+	}
+
+	return ctx, diags
+}
@@ -60,6 +60,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"api_call_diagnostics": schema.BoolAttribute{
+				Optional: true,
+				Description: "Report the AWS API calls made by each resource operation as a warning, for debugging. " +
+					"Only the operation, HTTP status code, request ID, duration and error code are reported.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -72,6 +77,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoints_file": schema.StringAttribute{
+				Optional: true,
+				Description: "Path to a JSON or YAML file mapping `endpoints` keys to service endpoint URLs. " +
+					"Endpoints configured in the `endpoints` block take precedence.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -92,6 +102,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The maximum number of times an AWS API request is\nbeing executed. If the API request still fails, an error is\nthrown.",
 			},
+			"max_retries_per_service": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: "The maximum number of times an AWS API request is being executed for specific services, keyed by `endpoints` key, e.g. `ec2`. Overrides `max_retries` for those services.",
+			},
 			"no_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
@@ -108,6 +123,11 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 			},
+			"retry_mode_per_service": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Specifies how retries are attempted for specific services, keyed by `endpoints` key, e.g. `ec2`. Valid values are `standard` and `adaptive`. Overrides `retry_mode` for those services.",
+			},
 			"s3_use_path_style": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Set this to true to enable the request to use path-style addressing,\ni.e., https://s3.amazonaws.com/BUCKET/KEY. By default, the S3 client will\nuse virtual hosted bucket addressing when possible\n(https://BUCKET.s3.amazonaws.com/KEY). Specific to the Amazon S3 service.",
@@ -260,6 +280,17 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"forbidden_tag_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that no resource may have. Plans including them fail.",
+						},
+						"required_tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tags that every taggable resource must have, including default tags. " +
+								"An empty value allows any value. Plans for resources missing them fail.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -292,6 +323,48 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"roles_anywhere": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"certificate_chain_file": schema.StringAttribute{
+							Optional:    true,
+							Description: "File containing the PEM encoded intermediate certificates used to build the trust chain to the trust anchor.",
+						},
+						"certificate_file": schema.StringAttribute{
+							Required:    true,
+							Description: "File containing the PEM encoded X.509 end-entity certificate.",
+						},
+						"duration": schema.StringAttribute{
+							CustomType:  fwtypes.DurationType,
+							Optional:    true,
+							Description: "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+						},
+						"private_key_file": schema.StringAttribute{
+							Required:    true,
+							Description: "File containing the unencrypted PEM encoded private key of the end-entity certificate.",
+						},
+						"profile_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of the IAM Roles Anywhere profile.",
+						},
+						"role_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of the IAM Role to obtain credentials for.",
+						},
+						"session_name": schema.StringAttribute{
+							Optional:    true,
+							Description: "An identifier for the role session.",
+						},
+						"trust_anchor_arn": schema.StringAttribute{
+							Required:    true,
+							Description: "Amazon Resource Name (ARN) of the IAM Roles Anywhere trust anchor.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -417,7 +490,9 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 
 				return ctx
 			}
-			interceptors := resourceInterceptors{}
+			interceptors := resourceInterceptors{
+				apiCallsResourceInterceptor{},
+			}
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
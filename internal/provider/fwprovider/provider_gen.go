@@ -191,6 +191,13 @@ func endpointsBlock() schema.SetNestedBlock {
 					Description: "Use this to override the default service endpoint URL",
 				},
 
+				// b2bi
+
+				"b2bi": schema.StringAttribute{
+					Optional:    true,
+					Description: "Use this to override the default service endpoint URL",
+				},
+
 				// backup
 
 				"backup": schema.StringAttribute{
@@ -1778,6 +1785,13 @@ func endpointsBlock() schema.SetNestedBlock {
 					Description: "Use this to override the default service endpoint URL",
 				},
 
+				// socialmessaging
+
+				"socialmessaging": schema.StringAttribute{
+					Optional:    true,
+					Description: "Use this to override the default service endpoint URL",
+				},
+
 				// sqs
 
 				"sqs": schema.StringAttribute{
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,6 +21,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -29,6 +32,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
 )
 
 // New returns a new, initialized Terraform Plugin SDK v2-style provider instance.
@@ -52,6 +56,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_call_diagnostics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Report the AWS API calls made by each resource operation as a warning, for debugging. " +
+					"Only the operation, HTTP status code, request ID, duration and error code are reported.",
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -68,6 +78,19 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"forbidden_tag_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that no resource may have. Plans including them fail.",
+						},
+						"required_tags": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tags that every taggable resource must have, including default tags. " +
+								"An empty value allows any value. Plans for resources missing them fail.",
+						},
 						"tags": {
 							Type:     schema.TypeMap,
 							Optional: true,
@@ -91,6 +114,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
 			"endpoints": endpointsSchema(),
+			"endpoints_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path to a JSON or YAML file mapping `endpoints` keys to service endpoint URLs. " +
+					"Endpoints configured in the `endpoints` block take precedence.",
+			},
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
 				Elem:          &schema.Schema{Type: schema.TypeString},
@@ -146,6 +175,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"max_retries_per_service": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Description: "The maximum number of times an AWS API request is being executed for specific services, " +
+					"keyed by `endpoints` key, e.g. `ec2`. Overrides `max_retries` for those services.",
+			},
 			"no_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -170,6 +206,14 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
 					"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
 			},
+			"retry_mode_per_service": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: "Specifies how retries are attempted for specific services, keyed by `endpoints` key, e.g. `ec2`. " +
+					"Valid values are `standard` and `adaptive`. Overrides `retry_mode` for those services.",
+			},
+			"roles_anywhere": rolesAnywhereSchema(),
 			"s3_use_path_style": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -377,7 +421,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 				return ctx
 			}
-			interceptors := interceptorItems{}
+			interceptors := interceptorItems{
+				{
+					when:        Before | Finally,
+					why:         AllOps,
+					interceptor: apiCallsResourceInterceptor{},
+				},
+			}
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -412,6 +462,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 						readFunc:   tagsReadFunc,
 					},
 				})
+
+				// Enforce the provider-level tag policy at plan time.
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(v, tagsPolicyCustomizeDiff)
+				} else {
+					r.CustomizeDiff = tagsPolicyCustomizeDiff
+				}
 			}
 
 			rs := &wrappedResource{
@@ -481,6 +538,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		APICallDiagnostics:             d.Get("api_call_diagnostics").(bool),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -559,14 +617,31 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("roles_anywhere"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.RolesAnywhere = expandRolesAnywhere(ctx, v.([]interface{})[0].(map[string]interface{}))
+		tflog.Info(ctx, "roles_anywhere configuration set", map[string]any{
+			"tf_aws.roles_anywhere.profile_arn":      config.RolesAnywhere.ProfileARN,
+			"tf_aws.roles_anywhere.role_arn":         config.RolesAnywhere.RoleARN,
+			"tf_aws.roles_anywhere.trust_anchor_arn": config.RolesAnywhere.TrustAnchorARN,
+		})
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	} else {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, nil)
 	}
 
-	v := d.Get("endpoints")
-	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
+	v := d.Get("endpoints").(*schema.Set).List()
+	if filename, ok := d.GetOk("endpoints_file"); ok {
+		fileEndpoints, dx := readEndpointsFile(ctx, filename.(string))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		v = mergeEndpointsFile(v, fileEndpoints)
+	}
+	endpoints, dx := expandEndpoints(ctx, v)
 	diags = append(diags, dx...)
 	if diags.HasError() {
 		return nil, diags
@@ -602,6 +677,24 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("max_retries_per_service"); ok && len(v.(map[string]any)) > 0 {
+		maxRetries, dx := expandMaxRetriesPerService(ctx, v.(map[string]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.MaxRetriesPerService = maxRetries
+	}
+
+	if v, ok := d.GetOk("retry_mode_per_service"); ok && len(v.(map[string]any)) > 0 {
+		retryModes, dx := expandRetryModePerService(ctx, v.(map[string]any))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.RetryModePerService = retryModes
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -761,6 +854,64 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func rolesAnywhereSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"access_key", "secret_key", "token"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"certificate_chain_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "File containing the PEM encoded intermediate certificates used to build the trust chain to the trust anchor.",
+				},
+				"certificate_file": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "File containing the PEM encoded X.509 end-entity certificate.",
+				},
+				"duration": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The duration, between 15 minutes and 12 hours, of the role session. Valid time units are ns, us (or µs), ms, s, h, or m.",
+					ValidateFunc: validAssumeRoleDuration,
+				},
+				"private_key_file": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "File containing the unencrypted PEM encoded private key of the end-entity certificate.",
+				},
+				"profile_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name (ARN) of the IAM Roles Anywhere profile.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"role_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name (ARN) of the IAM Role to obtain credentials for.",
+					ValidateFunc: verify.ValidARN,
+				},
+				"session_name": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "An identifier for the role session.",
+					ValidateFunc: validAssumeRoleSessionName,
+				},
+				"trust_anchor_arn": {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Amazon Resource Name (ARN) of the IAM Roles Anywhere trust anchor.",
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+		},
+	}
+}
+
 func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result []awsbase.AssumeRole, diags diag.Diagnostics) {
 	result = make([]awsbase.AssumeRole, len(tfList))
 
@@ -831,6 +982,49 @@ func expandAssumeRole(_ context.Context, path cty.Path, tfMap map[string]any) (r
 	return result, diags
 }
 
+func expandRolesAnywhere(_ context.Context, tfMap map[string]interface{}) *conns.RolesAnywhere {
+	if tfMap == nil {
+		return nil
+	}
+
+	rolesAnywhere := conns.RolesAnywhere{}
+
+	if v, ok := tfMap["certificate_chain_file"].(string); ok && v != "" {
+		rolesAnywhere.CertificateChainFile = v
+	}
+
+	if v, ok := tfMap["certificate_file"].(string); ok && v != "" {
+		rolesAnywhere.CertificateFile = v
+	}
+
+	if v, ok := tfMap["duration"].(string); ok && v != "" {
+		duration, _ := time.ParseDuration(v)
+		rolesAnywhere.Duration = duration
+	}
+
+	if v, ok := tfMap["private_key_file"].(string); ok && v != "" {
+		rolesAnywhere.PrivateKeyFile = v
+	}
+
+	if v, ok := tfMap["profile_arn"].(string); ok && v != "" {
+		rolesAnywhere.ProfileARN = v
+	}
+
+	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
+		rolesAnywhere.RoleARN = v
+	}
+
+	if v, ok := tfMap["session_name"].(string); ok && v != "" {
+		rolesAnywhere.SessionName = v
+	}
+
+	if v, ok := tfMap["trust_anchor_arn"].(string); ok && v != "" {
+		rolesAnywhere.TrustAnchorARN = v
+	}
+
+	return &rolesAnywhere
+}
+
 func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRoleWithWebIdentity {
 	if tfMap == nil {
 		return nil
@@ -885,13 +1079,159 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 		}
 	}
 
+	var defaultConfig tftags.DefaultConfig
+
 	if len(tags) > 0 {
-		return &tftags.DefaultConfig{
-			Tags: tftags.New(ctx, tags),
+		defaultConfig.Tags = tftags.New(ctx, tags)
+	}
+	if v, ok := tfMap["required_tags"].(map[string]interface{}); ok && len(v) > 0 {
+		defaultConfig.RequiredTags = tftags.New(ctx, v)
+	}
+	if v, ok := tfMap["forbidden_tag_keys"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.ForbiddenTagKeys = tftags.New(ctx, v.List())
+	}
+
+	if defaultConfig.Tags == nil && defaultConfig.RequiredTags == nil && defaultConfig.ForbiddenTagKeys == nil {
+		return nil
+	}
+
+	return &defaultConfig
+}
+
+// readEndpointsFile reads a JSON or YAML file mapping `endpoints` keys, e.g. "s3" or "dynamodb", to service endpoint URLs.
+// Keys are normalized to provider package names.
+func readEndpointsFile(_ context.Context, filename string) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	path := cty.GetAttrPath("endpoints_file")
+
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, append(diags, errs.NewInvalidValueAttributeErrorf(path, "reading endpoints file (%s): %s", filename, err))
+	}
+
+	// JSON is a subset of YAML.
+	var tfMap map[string]string
+	if err := yaml.Unmarshal(b, &tfMap); err != nil {
+		return nil, append(diags, errs.NewInvalidValueAttributeErrorf(path, "parsing endpoints file (%s): %s", filename, err))
+	}
+
+	endpoints := make(map[string]string, len(tfMap))
+	keys := slices.Sorted(maps.Keys(tfMap))
+	for _, k := range keys {
+		pkg, err := names.ProviderPackageForAlias(k)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "endpoints file (%s): unsupported service %q", filename, k))
+			continue
+		}
+
+		v := tfMap[k]
+		if v == "" {
+			continue
+		}
+
+		if endpoints[pkg] != "" && endpoints[pkg] != v {
+			diags = append(diags, errs.NewAttributeWarningDiagnostic(path,
+				"Conflicting Endpoints",
+				fmt.Sprintf("endpoints file (%s) sets more than one endpoint for %q; using %q", filename, pkg, endpoints[pkg]),
+			))
+			continue
 		}
+
+		endpoints[pkg] = v
+	}
+
+	return endpoints, diags
+}
+
+// mergeEndpointsFile adds the endpoints read from a file to the `endpoints` block for any service the block does not set.
+// Endpoints in the block therefore take precedence over the file, and the file over environment variables.
+func mergeEndpointsFile(tfList []any, fileEndpoints map[string]string) []any {
+	if len(fileEndpoints) == 0 {
+		return tfList
+	}
+
+	tfMap := make(map[string]any)
+	for k := range endpointsSchema().Elem.(*schema.Resource).Schema {
+		tfMap[k] = ""
+	}
+	if len(tfList) > 0 {
+		if v, ok := tfList[0].(map[string]any); ok {
+			maps.Copy(tfMap, v)
+		}
+	}
+
+	configured := make(map[string]bool)
+	for k, v := range tfMap {
+		if v == "" {
+			continue
+		}
+		if pkg, err := names.ProviderPackageForAlias(k); err == nil {
+			configured[pkg] = true
+		}
+	}
+
+	for pkg, v := range fileEndpoints {
+		if !configured[pkg] {
+			tfMap[pkg] = v
+		}
+	}
+
+	return append([]any{tfMap}, tfList[min(len(tfList), 1):]...)
+}
+
+// expandMaxRetriesPerService expands the `max_retries_per_service` map.
+// Keys are normalized to provider package names.
+func expandMaxRetriesPerService(_ context.Context, tfMap map[string]any) (map[string]int, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	path := cty.GetAttrPath("max_retries_per_service")
+	maxRetries := make(map[string]int, len(tfMap))
+
+	for _, k := range slices.Sorted(maps.Keys(tfMap)) {
+		pkg, err := names.ProviderPackageForAlias(k)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.IndexString(k), "unsupported service %q", k))
+			continue
+		}
+
+		v := tfMap[k].(int)
+		if v < 0 {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.IndexString(k), "must be at least 0, got %d", v))
+			continue
+		}
+
+		maxRetries[pkg] = v
+	}
+
+	return maxRetries, diags
+}
+
+// expandRetryModePerService expands the `retry_mode_per_service` map.
+// Keys are normalized to provider package names.
+func expandRetryModePerService(_ context.Context, tfMap map[string]any) (map[string]aws.RetryMode, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	path := cty.GetAttrPath("retry_mode_per_service")
+	retryModes := make(map[string]aws.RetryMode, len(tfMap))
+
+	for _, k := range slices.Sorted(maps.Keys(tfMap)) {
+		pkg, err := names.ProviderPackageForAlias(k)
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.IndexString(k), "unsupported service %q", k))
+			continue
+		}
+
+		mode, err := aws.ParseRetryMode(tfMap[k].(string))
+		if err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path.IndexString(k), "%s", err))
+			continue
+		}
+
+		retryModes[pkg] = mode
 	}
 
-	return nil
+	return retryModes, diags
 }
 
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
@@ -411,6 +411,51 @@ func TestProviderConfig_AssumeRole(t *testing.T) { //nolint:paralleltest
 			},
 		},
 
+		"config multiple three hops": {
+			Config: map[string]any{
+				"assume_role": []any{
+					map[string]any{
+						"role_arn":     servicemocks.MockStsAssumeRoleArn,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName,
+					},
+					map[string]any{
+						"external_id":  "ExternalId2",
+						"role_arn":     servicemocks.MockStsAssumeRoleArn2,
+						"session_name": servicemocks.MockStsAssumeRoleSessionName2,
+						"tags": map[string]any{
+							"Hop": "2",
+						},
+					},
+					map[string]any{
+						"external_id":  "ExternalId3",
+						"role_arn":     "arn:aws:iam::555555555555:role/AssumeRole3",
+						"session_name": "AssumeRoleSessionName3",
+						"tags": map[string]any{
+							"Hop": "3",
+						},
+					},
+				},
+			},
+			ExpectedCredentialsValue: mockdata.MockStsAssumeRoleCredentials,
+			MockStsEndpoints: []*servicemocks.MockEndpoint{
+				servicemocks.MockStsAssumeRoleValidEndpoint,
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"ExternalId":          "ExternalId2",
+					"RoleArn":             servicemocks.MockStsAssumeRoleArn2,
+					"RoleSessionName":     servicemocks.MockStsAssumeRoleSessionName2,
+					"Tags.member.1.Key":   "Hop",
+					"Tags.member.1.Value": "2",
+				}),
+				servicemocks.MockStsAssumeRoleValidEndpointWithOptions(map[string]string{
+					"ExternalId":          "ExternalId3",
+					"RoleArn":             "arn:aws:iam::555555555555:role/AssumeRole3",
+					"RoleSessionName":     "AssumeRoleSessionName3",
+					"Tags.member.1.Key":   "Hop",
+					"Tags.member.1.Value": "3",
+				}),
+			},
+		},
+
 		// For historical reasons, this is valid
 		"config empty": {
 			Config: map[string]any{
@@ -6,13 +6,16 @@ package provider
 import (
 	"context"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -71,6 +74,208 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestReadEndpointsFile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		contents          string
+		expectedEndpoints map[string]string
+		expectedDiags     diag.Diagnostics
+	}{
+		"json": {
+			contents: `{"s3": "http://localhost:4566", "prometheus": "http://localhost:4567"}`,
+			expectedEndpoints: map[string]string{
+				"amp": "http://localhost:4567",
+				"s3":  "http://localhost:4566",
+			},
+		},
+		"yaml": {
+			contents: "s3: http://localhost:4566\ndynamodb: http://localhost:8000\n",
+			expectedEndpoints: map[string]string{
+				"dynamodb": "http://localhost:8000",
+				"s3":       "http://localhost:4566",
+			},
+		},
+		"unsupported service": {
+			contents: `{"notaservice": "http://localhost:4566"}`,
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(cty.GetAttrPath("endpoints_file"), "endpoints file (%s): unsupported service %q", "FILENAME", "notaservice"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			filename := filepath.Join(t.TempDir(), "endpoints")
+			if err := os.WriteFile(filename, []byte(testcase.contents), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			endpoints, diags := readEndpointsFile(ctx, filename)
+
+			for i := range testcase.expectedDiags {
+				testcase.expectedDiags[i].Detail = strings.ReplaceAll(testcase.expectedDiags[i].Detail, "FILENAME", filename)
+			}
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testcase.expectedDiags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(endpoints, testcase.expectedEndpoints); diff != "" {
+				t.Errorf("unexpected endpoints difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandMaxRetriesPerService(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfMap              map[string]any
+		expectedMaxRetries map[string]int
+		expectedDiags      diag.Diagnostics
+	}{
+		"valid": {
+			tfMap: map[string]any{"ec2": 50, "prometheus": 10},
+			expectedMaxRetries: map[string]int{
+				"amp": 10,
+				"ec2": 50,
+			},
+		},
+		"unsupported service": {
+			tfMap: map[string]any{"notaservice": 50},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(cty.GetAttrPath("max_retries_per_service").IndexString("notaservice"), "unsupported service %q", "notaservice"),
+			},
+		},
+		"negative": {
+			tfMap: map[string]any{"iam": -1},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(cty.GetAttrPath("max_retries_per_service").IndexString("iam"), "must be at least 0, got %d", -1),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			maxRetries, diags := expandMaxRetriesPerService(ctx, testcase.tfMap)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testcase.expectedDiags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(maxRetries, testcase.expectedMaxRetries); diff != "" {
+				t.Errorf("unexpected max retries difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandRetryModePerService(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testcases := map[string]struct {
+		tfMap              map[string]any
+		expectedRetryModes map[string]aws.RetryMode
+		expectedDiags      diag.Diagnostics
+	}{
+		"valid": {
+			tfMap: map[string]any{"ec2": "adaptive", "iam": "standard"},
+			expectedRetryModes: map[string]aws.RetryMode{
+				"ec2": aws.RetryModeAdaptive,
+				"iam": aws.RetryModeStandard,
+			},
+		},
+		"unsupported service": {
+			tfMap: map[string]any{"notaservice": "adaptive"},
+			expectedDiags: diag.Diagnostics{
+				errs.NewInvalidValueAttributeErrorf(cty.GetAttrPath("retry_mode_per_service").IndexString("notaservice"), "unsupported service %q", "notaservice"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			retryModes, diags := expandRetryModePerService(ctx, testcase.tfMap)
+
+			if diff := cmp.Diff(diags, testcase.expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if testcase.expectedDiags.HasError() {
+				return
+			}
+
+			if diff := cmp.Diff(retryModes, testcase.expectedRetryModes); diff != "" {
+				t.Errorf("unexpected retry modes difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeEndpointsFile(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	tfMap := make(map[string]any)
+	for k := range endpointsSchema().Elem.(*schema.Resource).Schema {
+		tfMap[k] = ""
+	}
+	tfMap["s3"] = "http://block.example.com"
+	tfMap["prometheus"] = "http://block.example.com"
+
+	fileEndpoints := map[string]string{
+		"amp":      "http://file.example.com",
+		"dynamodb": "http://file.example.com",
+		"s3":       "http://file.example.com",
+	}
+
+	endpoints, diags := expandEndpoints(ctx, mergeEndpointsFile([]any{tfMap}, fileEndpoints))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	want := map[string]string{
+		"amp":      "http://block.example.com",
+		"dynamodb": "http://file.example.com",
+		"s3":       "http://block.example.com",
+	}
+	if diff := cmp.Diff(endpoints, want); diff != "" {
+		t.Errorf("unexpected endpoints difference: %s", diff)
+	}
+
+	endpoints, diags = expandEndpoints(ctx, mergeEndpointsFile(nil, fileEndpoints))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+
+	if diff := cmp.Diff(endpoints, fileEndpoints); diff != "" {
+		t.Errorf("unexpected endpoints difference: %s", diff)
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
@@ -307,6 +512,37 @@ func TestExpandDefaultTags(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandDefaultTags_policy(t *testing.T) { //nolint:paralleltest
+	ctx := context.Background()
+
+	oldEnv := stashEnv()
+	defer popEnv(oldEnv)
+
+	results := expandDefaultTags(ctx, map[string]interface{}{
+		"forbidden_tag_keys": schema.NewSet(schema.HashString, []interface{}{"Temporary"}),
+		"required_tags": map[string]interface{}{
+			"CostCenter":  "",
+			"Environment": "production",
+		},
+	})
+
+	if results == nil {
+		t.Fatal("Expected default tags config, got nil")
+	}
+
+	if results.Tags != nil {
+		t.Errorf("Expected no default tags, got %v", results.Tags)
+	}
+
+	if want := map[string]string{"CostCenter": "", "Environment": "production"}; !cmp.Equal(results.RequiredTags.Map(), want) {
+		t.Errorf("Expected required tags to be %v, got %v", want, results.RequiredTags.Map())
+	}
+
+	if want := []string{"Temporary"}; !cmp.Equal(results.ForbiddenTagKeys.Keys(), want) {
+		t.Errorf("Expected forbidden tag keys to be %v, got %v", want, results.ForbiddenTagKeys.Keys())
+	}
+}
+
 func TestExpandIgnoreTags(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := map[string]struct {
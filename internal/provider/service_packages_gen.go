@@ -30,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/autoscalingplans"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/backup"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/batch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/bcmdataexports"
@@ -227,6 +228,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/signer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/simpledb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sns"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/socialmessaging"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
@@ -281,6 +283,7 @@ func servicePackages(ctx context.Context) []conns.ServicePackage {
 		auditmanager.ServicePackage(ctx),
 		autoscaling.ServicePackage(ctx),
 		autoscalingplans.ServicePackage(ctx),
+		b2bi.ServicePackage(ctx),
 		backup.ServicePackage(ctx),
 		batch.ServicePackage(ctx),
 		bcmdataexports.ServicePackage(ctx),
@@ -478,6 +481,7 @@ func servicePackages(ctx context.Context) []conns.ServicePackage {
 		signer.ServicePackage(ctx),
 		simpledb.ServicePackage(ctx),
 		sns.ServicePackage(ctx),
+		socialmessaging.ServicePackage(ctx),
 		sqs.ServicePackage(ctx),
 		ssm.ServicePackage(ctx),
 		ssmcontacts.ServicePackage(ctx),
@@ -5,10 +5,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -170,3 +172,26 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagsPolicyCustomizeDiff fails the plan if a resource's tags, merged with the provider-level default tags,
+// violate the provider-level required_tags or forbidden_tag_keys.
+func tagsPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	// Unknown tags are validated once known.
+	if plan := d.GetRawPlan(); plan.IsNull() || !plan.GetAttr(names.AttrTags).IsWhollyKnown() {
+		return nil
+	}
+
+	resourceTags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+	allTags := tagsInContext.DefaultConfig.MergeTags(resourceTags)
+
+	if err := tagsInContext.DefaultConfig.ValidateTags(allTags); err != nil {
+		return fmt.Errorf("%s do not satisfy the provider's default_tags policy: %w", names.AttrTags, err)
+	}
+
+	return nil
+}
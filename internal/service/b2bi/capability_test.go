@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/b2bi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfb2bi "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccB2BICapability_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var capability b2bi.GetCapabilityOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_capability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.B2BI)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.B2BIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapabilityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilityConfig_basic(rName, "input"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapabilityExists(ctx, resourceName, &capability),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "b2bi", regexache.MustCompile(`capability/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.edi.0.input_location.0.bucket_name", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.input_location.0.key", "input"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.edi.0.output_location.0.bucket_name", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.output_location.0.key", "output"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.edi.0.transformer_id", "aws_b2bi_transformer.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.type.0.x12_details.0.transaction_set", "X12_110"),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.type.0.x12_details.0.version", "VERSION_4010"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, "instructions_documents.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "edi"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccCapabilityConfig_basic(rName, "input-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCapabilityExists(ctx, resourceName, &capability),
+					resource.TestCheckResourceAttr(resourceName, "configuration.0.edi.0.input_location.0.key", "input-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccB2BICapability_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var capability b2bi.GetCapabilityOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_capability.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.B2BI)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.B2BIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCapabilityDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCapabilityConfig_basic(rName, "input"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCapabilityExists(ctx, resourceName, &capability),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfb2bi.ResourceCapability, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCapabilityDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_b2bi_capability" {
+				continue
+			}
+
+			_, err := tfb2bi.FindCapabilityByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("B2B Data Interchange Capability %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCapabilityExists(ctx context.Context, n string, v *b2bi.GetCapabilityOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIClient(ctx)
+
+		output, err := tfb2bi.FindCapabilityByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCapabilityConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccTransformerConfig_basic(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "b2bi.amazonaws.com"
+      }
+      Action   = ["s3:GetObject", "s3:PutObject", "s3:DeleteObject"]
+      Resource = "${aws_s3_bucket.test.arn}/*"
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccCapabilityConfig_basic(rName, inputKey string) string {
+	return acctest.ConfigCompose(testAccCapabilityConfig_base(rName), fmt.Sprintf(`
+resource "aws_b2bi_capability" "test" {
+  name = %[1]q
+  type = "edi"
+
+  configuration {
+    edi {
+      transformer_id = aws_b2bi_transformer.test.id
+
+      input_location {
+        bucket_name = aws_s3_bucket.test.bucket
+        key         = %[2]q
+      }
+
+      output_location {
+        bucket_name = aws_s3_bucket.test.bucket
+        key         = "output"
+      }
+
+      type {
+        x12_details {
+          transaction_set = "X12_110"
+          version         = "VERSION_4010"
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+`, rName, inputKey))
+}
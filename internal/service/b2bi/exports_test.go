@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi
+
+// Exports for use in tests only.
+var (
+	ResourceCapability  = newCapabilityResource
+	ResourcePartnership = newPartnershipResource
+	ResourceProfile     = newProfileResource
+	ResourceTransformer = newTransformerResource
+
+	FindCapabilityByID  = findCapabilityByID
+	FindPartnershipByID = findPartnershipByID
+	FindProfileByID     = findProfileByID
+	FindTransformerByID = findTransformerByID
+)
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/b2bi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfb2bi "github.com/hashicorp/terraform-provider-aws/internal/service/b2bi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccB2BIPartnership_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var partnership b2bi.GetPartnershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_partnership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.B2BI)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.B2BIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnershipConfig_basic(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPartnershipExists(ctx, resourceName, &partnership),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "b2bi", regexache.MustCompile(`partnership/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "capabilities.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "capabilities.0", "aws_b2bi_capability.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEmail, "partner@example.com"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_id", "aws_b2bi_profile.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "trading_partner_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPartnershipConfig_basic(rName, rName+"-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPartnershipExists(ctx, resourceName, &partnership),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccB2BIPartnership_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var partnership b2bi.GetPartnershipOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_b2bi_partnership.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.B2BI)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.B2BIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPartnershipDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPartnershipConfig_basic(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPartnershipExists(ctx, resourceName, &partnership),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfb2bi.ResourcePartnership, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPartnershipDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_b2bi_partnership" {
+				continue
+			}
+
+			_, err := tfb2bi.FindPartnershipByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("B2B Data Interchange Partnership %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPartnershipExists(ctx context.Context, n string, v *b2bi.GetPartnershipOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).B2BIClient(ctx)
+
+		output, err := tfb2bi.FindPartnershipByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPartnershipConfig_basic(rName, partnershipName string) string {
+	return acctest.ConfigCompose(testAccCapabilityConfig_basic(rName, "input"), testAccProfileConfig_basic(rName, "Example Corp"), fmt.Sprintf(`
+resource "aws_b2bi_partnership" "test" {
+  name         = %[1]q
+  email        = "partner@example.com"
+  profile_id   = aws_b2bi_profile.test.id
+  capabilities = [aws_b2bi_capability.test.id]
+}
+`, partnershipName))
+}
@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package b2bi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/b2bi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/b2bi/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_b2bi_transformer", name="Transformer")
+// @Tags(identifierAttribute="arn")
+func newTransformerResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &transformerResource{}
+
+	return r, nil
+}
+
+type transformerResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*transformerResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_b2bi_transformer"
+}
+
+func (r *transformerResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_format": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FileFormat](),
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"mapping_template": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(350000),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 254),
+				},
+			},
+			"sample_document": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TransformerStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"edi_type": ediTypeBlock(ctx),
+		},
+	}
+}
+
+func (r *transformerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIClient(ctx)
+
+	name := data.Name.ValueString()
+	input := b2bi.CreateTransformerInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateTransformer(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating B2B Data Interchange Transformer (%s)", name), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.TransformerId)
+
+	// New transformers are always created inactive.
+	if !data.Status.IsUnknown() && data.Status.ValueEnum() != output.Status {
+		input := b2bi.UpdateTransformerInput{
+			Status:        data.Status.ValueEnum(),
+			TransformerId: aws.String(id),
+		}
+
+		_, err := conn.UpdateTransformer(ctx, &input)
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("updating B2B Data Interchange Transformer (%s) status", id), err.Error())
+
+			return
+		}
+
+		output.Status = data.Status.ValueEnum()
+	}
+
+	// Set values for unknowns.
+	data.CreatedAt = timetypes.NewRFC3339TimePointerValue(output.CreatedAt)
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+	data.TransformerARN = fwflex.StringToFramework(ctx, output.TransformerArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *transformerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIClient(ctx)
+
+	output, err := findTransformerByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading B2B Data Interchange Transformer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *transformerResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new transformerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIClient(ctx)
+
+	if !new.EdiType.Equal(old.EdiType) ||
+		!new.FileFormat.Equal(old.FileFormat) ||
+		!new.MappingTemplate.Equal(old.MappingTemplate) ||
+		!new.Name.Equal(old.Name) ||
+		!new.SampleDocument.Equal(old.SampleDocument) ||
+		!new.Status.Equal(old.Status) {
+		input := b2bi.UpdateTransformerInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.TransformerId = fwflex.StringFromFramework(ctx, new.ID)
+
+		output, err := conn.UpdateTransformer(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating B2B Data Interchange Transformer (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(output.Status)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *transformerResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data transformerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().B2BIClient(ctx)
+
+	_, err := conn.DeleteTransformer(ctx, &b2bi.DeleteTransformerInput{
+		TransformerId: data.ID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting B2B Data Interchange Transformer (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *transformerResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findTransformerByID(ctx context.Context, conn *b2bi.Client, id string) (*b2bi.GetTransformerOutput, error) {
+	input := &b2bi.GetTransformerInput{
+		TransformerId: aws.String(id),
+	}
+
+	output, err := conn.GetTransformer(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func ediTypeBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[ediTypeModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"x12_details": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[x12DetailsModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"transaction_set": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.X12TransactionSet](),
+								Required:   true,
+							},
+							names.AttrVersion: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.X12Version](),
+								Required:   true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type transformerResourceModel struct {
+	CreatedAt       timetypes.RFC3339                              `tfsdk:"created_at"`
+	EdiType         fwtypes.ListNestedObjectValueOf[ediTypeModel]  `tfsdk:"edi_type"`
+	FileFormat      fwtypes.StringEnum[awstypes.FileFormat]        `tfsdk:"file_format"`
+	ID              types.String                                   `tfsdk:"id"`
+	MappingTemplate types.String                                   `tfsdk:"mapping_template"`
+	Name            types.String                                   `tfsdk:"name"`
+	SampleDocument  types.String                                   `tfsdk:"sample_document"`
+	Status          fwtypes.StringEnum[awstypes.TransformerStatus] `tfsdk:"status"`
+	Tags            tftags.Map                                     `tfsdk:"tags"`
+	TagsAll         tftags.Map                                     `tfsdk:"tags_all"`
+	TransformerARN  types.String                                   `tfsdk:"arn"`
+}
+
+type ediTypeModel struct {
+	X12Details fwtypes.ListNestedObjectValueOf[x12DetailsModel] `tfsdk:"x12_details"`
+}
+
+var (
+	_ fwflex.Expander  = ediTypeModel{}
+	_ fwflex.Flattener = &ediTypeModel{}
+)
+
+func (m ediTypeModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.X12Details.IsNull():
+		x12DetailsData, d := m.X12Details.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.EdiTypeMemberX12Details
+		diags.Append(fwflex.Expand(ctx, x12DetailsData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *ediTypeModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.EdiTypeMemberX12Details:
+		var model x12DetailsModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.X12Details = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type x12DetailsModel struct {
+	TransactionSet fwtypes.StringEnum[awstypes.X12TransactionSet] `tfsdk:"transaction_set"`
+	Version        fwtypes.StringEnum[awstypes.X12Version]        `tfsdk:"version"`
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -142,10 +143,28 @@ func (r *resourceGuardrail) Schema(ctx context.Context, req resource.SchemaReque
 							CustomType: fwtypes.NewSetNestedObjectTypeOf[filtersConfig](ctx),
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
+									"input_modalities": schema.SetAttribute{
+										CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.GuardrailModality]](ctx),
+										ElementType: fwtypes.StringEnumType[awstypes.GuardrailModality](),
+										Optional:    true,
+										Computed:    true,
+										Validators: []validator.Set{
+											setvalidator.SizeAtLeast(1),
+										},
+									},
 									"input_strength": schema.StringAttribute{
 										Required:   true,
 										CustomType: fwtypes.StringEnumType[awstypes.GuardrailFilterStrength](),
 									},
+									"output_modalities": schema.SetAttribute{
+										CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.GuardrailModality]](ctx),
+										ElementType: fwtypes.StringEnumType[awstypes.GuardrailModality](),
+										Optional:    true,
+										Computed:    true,
+										Validators: []validator.Set{
+											setvalidator.SizeAtLeast(1),
+										},
+									},
 									"output_strength": schema.StringAttribute{
 										Required:   true,
 										CustomType: fwtypes.StringEnumType[awstypes.GuardrailFilterStrength](),
@@ -342,7 +361,8 @@ func (r *resourceGuardrail) Schema(ctx context.Context, req resource.SchemaReque
 const (
 	filtersConfigThresholdMin = 0.000000
 
-	guardrailIDParts = 2
+	guardrailDraftVersion = "DRAFT"
+	guardrailIDParts      = 2
 )
 
 var (
@@ -445,16 +465,7 @@ func (r *resourceGuardrail) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	if !plan.BlockedInputMessaging.Equal(state.BlockedInputMessaging) ||
-		!plan.BlockedOutputsMessaging.Equal(state.BlockedOutputsMessaging) ||
-		!plan.KmsKeyId.Equal(state.KmsKeyId) ||
-		!plan.ContentPolicy.Equal(state.ContentPolicy) ||
-		!plan.ContextualGroundingPolicy.Equal(state.ContextualGroundingPolicy) ||
-		!plan.SensitiveInformationPolicy.Equal(state.SensitiveInformationPolicy) ||
-		!plan.TopicPolicy.Equal(state.TopicPolicy) ||
-		!plan.WordPolicy.Equal(state.WordPolicy) ||
-		!plan.Name.Equal(state.Name) ||
-		!plan.Description.Equal(state.Description) {
+	if plan.hasDraftChanges(state) {
 		in := &bedrock.UpdateGuardrailInput{
 			GuardrailIdentifier: plan.GuardrailID.ValueStringPointer(),
 		}
@@ -480,9 +491,11 @@ func (r *resourceGuardrail) Update(ctx context.Context, req resource.UpdateReque
 		}
 		plan.GuardrailArn = fwflex.StringToFramework(ctx, out.GuardrailArn)
 		plan.GuardrailID = fwflex.StringToFramework(ctx, out.GuardrailId)
+		// Updates always apply to the working draft, regardless of the version in state.
+		plan.Version = fwflex.StringToFramework(ctx, out.Version)
 
 		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
-		if _, err := waitGuardrailUpdated(ctx, conn, plan.GuardrailID.ValueString(), state.Version.ValueString(), updateTimeout); err != nil {
+		if _, err := waitGuardrailUpdated(ctx, conn, plan.GuardrailID.ValueString(), plan.Version.ValueString(), updateTimeout); err != nil {
 			resp.Diagnostics.AddError(
 				create.ProblemStandardMessage(names.Bedrock, create.ErrActionWaitingForUpdate, ResNameGuardrail, plan.GuardrailID.String(), err),
 				err.Error(),
@@ -553,6 +566,22 @@ func (r *resourceGuardrail) ImportState(ctx context.Context, req resource.Import
 
 func (r *resourceGuardrail) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state resourceGuardrailData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes are made to the working draft, so a guardrail imported at a numbered version moves to DRAFT.
+	if plan.hasDraftChanges(state) && plan.Version.ValueString() != guardrailDraftVersion {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(names.AttrVersion), guardrailDraftVersion)...)
+	}
 }
 
 func waitGuardrailCreated(ctx context.Context, conn *bedrock.Client, id string, version string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) { //nolint:unparam
@@ -669,14 +698,30 @@ type resourceGuardrailData struct {
 	WordPolicy                 fwtypes.ListNestedObjectValueOf[wordPolicyConfig]                 `tfsdk:"word_policy_config"`
 }
 
+// hasDraftChanges returns whether any attribute stored in the guardrail's working draft differs between d and old.
+func (d resourceGuardrailData) hasDraftChanges(old resourceGuardrailData) bool {
+	return !d.BlockedInputMessaging.Equal(old.BlockedInputMessaging) ||
+		!d.BlockedOutputsMessaging.Equal(old.BlockedOutputsMessaging) ||
+		!d.KmsKeyId.Equal(old.KmsKeyId) ||
+		!d.ContentPolicy.Equal(old.ContentPolicy) ||
+		!d.ContextualGroundingPolicy.Equal(old.ContextualGroundingPolicy) ||
+		!d.SensitiveInformationPolicy.Equal(old.SensitiveInformationPolicy) ||
+		!d.TopicPolicy.Equal(old.TopicPolicy) ||
+		!d.WordPolicy.Equal(old.WordPolicy) ||
+		!d.Name.Equal(old.Name) ||
+		!d.Description.Equal(old.Description)
+}
+
 type contentPolicyConfig struct {
 	Filters fwtypes.SetNestedObjectValueOf[filtersConfig] `tfsdk:"filters_config"`
 }
 
 type filtersConfig struct {
-	InputStrength  fwtypes.StringEnum[awstypes.GuardrailFilterStrength]    `tfsdk:"input_strength"`
-	OutputStrength fwtypes.StringEnum[awstypes.GuardrailFilterStrength]    `tfsdk:"output_strength"`
-	Type           fwtypes.StringEnum[awstypes.GuardrailContentFilterType] `tfsdk:"type"`
+	InputModalities  fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.GuardrailModality]] `tfsdk:"input_modalities"`
+	InputStrength    fwtypes.StringEnum[awstypes.GuardrailFilterStrength]               `tfsdk:"input_strength"`
+	OutputModalities fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.GuardrailModality]] `tfsdk:"output_modalities"`
+	OutputStrength   fwtypes.StringEnum[awstypes.GuardrailFilterStrength]               `tfsdk:"output_strength"`
+	Type             fwtypes.StringEnum[awstypes.GuardrailContentFilterType]            `tfsdk:"type"`
 }
 
 type contextualGroundingPolicyConfig struct {
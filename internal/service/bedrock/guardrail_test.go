@@ -203,6 +203,59 @@ func TestAccBedrockGuardrail_update(t *testing.T) {
 	})
 }
 
+func TestAccBedrockGuardrail_contentFilterModalities(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_guardrail.test"
+	var guardrail bedrock.GetGuardrailOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGuardrailDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailConfig_contentFilterModalities(rName, `["TEXT"]`, `["TEXT"]`, "0.4", "0.5"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGuardrailExists(ctx, resourceName, &guardrail),
+					resource.TestCheckResourceAttr(resourceName, "content_policy_config.0.filters_config.0.input_modalities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "content_policy_config.0.filters_config.0.input_modalities.*", "TEXT"),
+					resource.TestCheckResourceAttr(resourceName, "content_policy_config.0.filters_config.0.output_modalities.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "content_policy_config.0.filters_config.0.output_modalities.*", "TEXT"),
+					resource.TestCheckResourceAttr(resourceName, "contextual_grounding_policy_config.0.filters_config.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "contextual_grounding_policy_config.0.filters_config.0.threshold", "0.4"),
+					resource.TestCheckResourceAttr(resourceName, "contextual_grounding_policy_config.0.filters_config.1.threshold", "0.5"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "DRAFT"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccGuardrailImportStateIDFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "guardrail_id",
+			},
+			{
+				Config: testAccGuardrailConfig_contentFilterModalities(rName, `["IMAGE", "TEXT"]`, `["IMAGE", "TEXT"]`, "0.6", "0.7"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGuardrailExists(ctx, resourceName, &guardrail),
+					resource.TestCheckResourceAttr(resourceName, "content_policy_config.0.filters_config.0.input_modalities.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "content_policy_config.0.filters_config.0.input_modalities.*", "IMAGE"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "content_policy_config.0.filters_config.0.input_modalities.*", "TEXT"),
+					resource.TestCheckResourceAttr(resourceName, "content_policy_config.0.filters_config.0.output_modalities.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "contextual_grounding_policy_config.0.filters_config.0.threshold", "0.6"),
+					resource.TestCheckResourceAttr(resourceName, "contextual_grounding_policy_config.0.filters_config.1.threshold", "0.7"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "DRAFT"),
+				),
+			},
+		},
+	})
+}
+
 func testAccGuardrailImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -449,3 +502,35 @@ resource "aws_bedrock_guardrail" "test" {
 }
 `, rName))
 }
+
+func testAccGuardrailConfig_contentFilterModalities(rName, inputModalities, outputModalities, groundingThreshold, relevanceThreshold string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_guardrail" "test" {
+  name                      = %[1]q
+  blocked_input_messaging   = "test"
+  blocked_outputs_messaging = "test"
+  description               = "test"
+
+  content_policy_config {
+    filters_config {
+      input_modalities  = %[2]s
+      input_strength    = "HIGH"
+      output_modalities = %[3]s
+      output_strength   = "HIGH"
+      type              = "VIOLENCE"
+    }
+  }
+
+  contextual_grounding_policy_config {
+    filters_config {
+      threshold = %[4]s
+      type      = "GROUNDING"
+    }
+    filters_config {
+      threshold = %[5]s
+      type      = "RELEVANCE"
+    }
+  }
+}
+`, rName, inputModalities, outputModalities, groundingThreshold, relevanceThreshold)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -72,6 +73,14 @@ func (r *guardrailVersionResource) Schema(ctx context.Context, request resource.
 			names.AttrSkipDestroy: schema.BoolAttribute{
 				Optional: true,
 			},
+			"triggers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
 			names.AttrVersion: schema.StringAttribute{
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -202,9 +211,10 @@ func (r *guardrailVersionResource) ImportState(ctx context.Context, request reso
 }
 
 type guardrailVersionResourceModel struct {
-	Description  types.String   `tfsdk:"description"`
-	GuardrailARN fwtypes.ARN    `tfsdk:"guardrail_arn"`
-	SkipDestroy  types.Bool     `tfsdk:"skip_destroy"`
-	Timeouts     timeouts.Value `tfsdk:"timeouts"`
-	Version      types.String   `tfsdk:"version"`
+	Description  types.String        `tfsdk:"description"`
+	GuardrailARN fwtypes.ARN         `tfsdk:"guardrail_arn"`
+	SkipDestroy  types.Bool          `tfsdk:"skip_destroy"`
+	Timeouts     timeouts.Value      `tfsdk:"timeouts"`
+	Triggers     fwtypes.MapOfString `tfsdk:"triggers"`
+	Version      types.String        `tfsdk:"version"`
 }
@@ -122,6 +122,46 @@ func TestAccBedrockGuardrailVersion_skipDestroy(t *testing.T) {
 	})
 }
 
+func TestAccBedrockGuardrailVersion_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var guardrailversion bedrock.GetGuardrailOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_guardrail_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGuardrailVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailVersion_triggers(rName, "MEDIUM"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGuardrailVersionExists(ctx, resourceName, &guardrailversion),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+				),
+			},
+			{
+				Config: testAccGuardrailVersion_triggers(rName, "HIGH"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGuardrailVersionExists(ctx, resourceName, &guardrailversion),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+					resource.TestCheckResourceAttr("aws_bedrock_guardrail.test", "content_policy_config.0.filters_config.0.input_strength", "HIGH"),
+					resource.TestCheckResourceAttr("aws_bedrock_guardrail.test", names.AttrVersion, "DRAFT"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGuardrailVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
@@ -229,3 +269,31 @@ resource "aws_bedrock_guardrail_version" "test" {
 }
 `, rName)
 }
+
+func testAccGuardrailVersion_triggers(rName, inputStrength string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrock_guardrail" "test" {
+  name                      = %[1]q
+  blocked_input_messaging   = "test"
+  blocked_outputs_messaging = "test"
+  description               = "test"
+
+  content_policy_config {
+    filters_config {
+      input_strength  = %[2]q
+      output_strength = "MEDIUM"
+      type            = "HATE"
+    }
+  }
+}
+
+resource "aws_bedrock_guardrail_version" "test" {
+  description   = %[1]q
+  guardrail_arn = aws_bedrock_guardrail.test.guardrail_arn
+
+  triggers = {
+    content_policy = sha256(jsonencode(aws_bedrock_guardrail.test.content_policy_config))
+  }
+}
+`, rName, inputStrength)
+}
@@ -0,0 +1,415 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Agent Version")
+func newAgentVersionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &agentVersionResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type agentVersionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithTimeouts
+}
+
+func (*agentVersionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_agent_version"
+}
+
+func (r *agentVersionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"agent_arn": framework.ARNAttributeComputedOnly(),
+			"agent_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"agent_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"agent_version": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"foundation_model": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"prepare_agent": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *agentVersionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data agentVersionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	agentID := data.AgentID.ValueString()
+	timeout := r.CreateTimeout(ctx, data.Timeouts)
+
+	// Make sure the DRAFT version reflects the latest agent configuration before it's snapshotted.
+	if data.PrepareAgent.ValueBool() {
+		if _, err := prepareAgent(ctx, conn, agentID, timeout); err != nil {
+			response.Diagnostics.AddError("creating Bedrock Agent Version", err.Error())
+
+			return
+		}
+	}
+
+	version, err := createAgentVersion(ctx, conn, agentID, timeout)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent (%s) Version", agentID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.Version = fwflex.StringValueToFramework(ctx, version)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("creating Bedrock Agent Version", err.Error())
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	output, err := waitAgentVersionCreated(ctx, conn, agentID, version, timeout)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent Version (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *agentVersionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data agentVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findAgentVersionByTwoPartKey(ctx, conn, data.AgentID.ValueString(), data.Version.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Version (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *agentVersionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data agentVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteAgentVersion(ctx, &bedrockagent.DeleteAgentVersionInput{
+		AgentId:      fwflex.StringFromFramework(ctx, data.AgentID),
+		AgentVersion: fwflex.StringFromFramework(ctx, data.Version),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Version (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitAgentVersionDeleted(ctx, conn, data.AgentID.ValueString(), data.Version.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Agent Version (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *agentVersionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), request.ID)...)
+	// Set prepare_agent to default value on import
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("prepare_agent"), true)...)
+}
+
+// createAgentVersion snapshots the agent's DRAFT version into a new numbered version.
+// The Bedrock Agents API has no dedicated operation for this; a version is created
+// as a side effect of creating an alias with no routing configuration, so a
+// short-lived alias is created and removed again once the version number is known.
+func createAgentVersion(ctx context.Context, conn *bedrockagent.Client, agentID string, timeout time.Duration) (string, error) {
+	input := &bedrockagent.CreateAgentAliasInput{
+		AgentAliasName: aws.String(id.PrefixedUniqueId("tf-version-")),
+		AgentId:        aws.String(agentID),
+		ClientToken:    aws.String(id.UniqueId()),
+	}
+
+	output, err := conn.CreateAgentAlias(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	aliasID := aws.ToString(output.AgentAlias.AgentAliasId)
+
+	alias, err := waitAgentAliasCreated(ctx, conn, aliasID, agentID, timeout)
+
+	if err != nil {
+		return "", fmt.Errorf("waiting for Bedrock Agent Alias (%s) create: %w", aliasID, err)
+	}
+
+	if len(alias.RoutingConfiguration) == 0 {
+		return "", fmt.Errorf("Bedrock Agent Alias (%s) has no routing configuration", aliasID)
+	}
+
+	version := aws.ToString(alias.RoutingConfiguration[0].AgentVersion)
+
+	_, err = conn.DeleteAgentAlias(ctx, &bedrockagent.DeleteAgentAliasInput{
+		AgentAliasId: aws.String(aliasID),
+		AgentId:      aws.String(agentID),
+	})
+
+	if err != nil && !errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return "", fmt.Errorf("deleting Bedrock Agent Alias (%s): %w", aliasID, err)
+	}
+
+	if _, err := waitAgentVersioned(ctx, conn, agentID, timeout); err != nil {
+		return "", fmt.Errorf("waiting for Bedrock Agent (%s) version: %w", agentID, err)
+	}
+
+	return version, nil
+}
+
+func findAgentVersionByTwoPartKey(ctx context.Context, conn *bedrockagent.Client, agentID, agentVersion string) (*awstypes.AgentVersion, error) {
+	input := &bedrockagent.GetAgentVersionInput{
+		AgentId:      aws.String(agentID),
+		AgentVersion: aws.String(agentVersion),
+	}
+
+	output, err := conn.GetAgentVersion(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AgentVersion == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AgentVersion, nil
+}
+
+func statusAgentVersion(ctx context.Context, conn *bedrockagent.Client, agentID, agentVersion string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAgentVersionByTwoPartKey(ctx, conn, agentID, agentVersion)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.AgentStatus), nil
+	}
+}
+
+func waitAgentVersionCreated(ctx context.Context, conn *bedrockagent.Client, agentID, agentVersion string, timeout time.Duration) (*awstypes.AgentVersion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusCreating, awstypes.AgentStatusVersioning),
+		Target:  enum.Slice(awstypes.AgentStatusPrepared),
+		Refresh: statusAgentVersion(ctx, conn, agentID, agentVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AgentVersion); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAgentVersionDeleted(ctx context.Context, conn *bedrockagent.Client, agentID, agentVersion string, timeout time.Duration) (*awstypes.AgentVersion, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AgentStatusDeleting, awstypes.AgentStatusPrepared),
+		Target:  []string{},
+		Refresh: statusAgentVersion(ctx, conn, agentID, agentVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AgentVersion); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.FailureReasons, errors.New)...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type agentVersionResourceModel struct {
+	AgentARN        types.String        `tfsdk:"agent_arn"`
+	AgentID         types.String        `tfsdk:"agent_id"`
+	AgentName       types.String        `tfsdk:"agent_name"`
+	Description     types.String        `tfsdk:"description"`
+	FoundationModel types.String        `tfsdk:"foundation_model"`
+	ID              types.String        `tfsdk:"id"`
+	PrepareAgent    types.Bool          `tfsdk:"prepare_agent"`
+	Timeouts        timeouts.Value      `tfsdk:"timeouts"`
+	Triggers        fwtypes.MapOfString `tfsdk:"triggers"`
+	Version         types.String        `tfsdk:"agent_version"`
+}
+
+const (
+	agentVersionResourceIDPartCount = 2
+)
+
+func (m *agentVersionResourceModel) InitFromID() error {
+	id := m.ID.ValueString()
+	parts, err := flex.ExpandResourceId(id, agentVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	m.AgentID = types.StringValue(parts[0])
+	m.Version = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *agentVersionResourceModel) setID() (string, error) {
+	parts := []string{
+		m.AgentID.ValueString(),
+		m.Version.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, agentVersionResourceIDPartCount, false)
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrockagent "github.com/hashicorp/terraform-provider-aws/internal/service/bedrockagent"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockAgentAgentVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_version.test"
+	var v awstypes.AgentVersion
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentVersionConfig_basic(rName, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "agent_arn", "aws_bedrockagent_agent.test", "agent_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "agent_id", "aws_bedrockagent_agent.test", "agent_id"),
+					resource.TestCheckResourceAttr(resourceName, "agent_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "agent_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "foundation_model", "anthropic.claude-v2"),
+					resource.TestCheckResourceAttr(resourceName, "prepare_agent", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgentVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_version.test"
+	var v awstypes.AgentVersion
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentVersionConfig_basic(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAgentVersionExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrockagent.ResourceAgentVersion, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockAgentAgentVersion_aliasPromotion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrockagent_agent_version.test"
+	aliasResourceName := "aws_bedrockagent_agent_alias.test"
+	var v awstypes.AgentVersion
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockAgentServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAgentVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAgentVersionConfig_alias(rName, "basic claude", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "agent_version", "1"),
+					resource.TestCheckResourceAttr(aliasResourceName, "routing_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(aliasResourceName, "routing_configuration.0.agent_version", resourceName, "agent_version"),
+				),
+			},
+			{
+				Config: testAccAgentVersionConfig_alias(rName, "basic claude updated", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAgentVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "agent_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "basic claude updated"),
+					resource.TestCheckResourceAttrPair(aliasResourceName, "routing_configuration.0.agent_version", resourceName, "agent_version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAgentVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrockagent_agent_version" {
+				continue
+			}
+
+			_, err := tfbedrockagent.FindAgentVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["agent_id"], rs.Primary.Attributes["agent_version"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Agent Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckAgentVersionExists(ctx context.Context, n string, v *awstypes.AgentVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockAgentClient(ctx)
+
+		output, err := tfbedrockagent.FindAgentVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["agent_id"], rs.Primary.Attributes["agent_version"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccAgentVersionConfig_version(trigger string) string {
+	return fmt.Sprintf(`
+resource "aws_bedrockagent_agent_version" "test" {
+  agent_id = aws_bedrockagent_agent.test.agent_id
+
+  triggers = {
+    revision = %[1]q
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, trigger)
+}
+
+func testAccAgentVersionConfig_basic(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccAgentConfig_basic(rName, "anthropic.claude-v2", "basic claude"), testAccAgentVersionConfig_version(trigger))
+}
+
+func testAccAgentVersionConfig_alias(rName, description, trigger string) string {
+	return acctest.ConfigCompose(
+		testAccAgentConfig_basic(rName, "anthropic.claude-v2", description),
+		testAccAgentVersionConfig_version(trigger),
+		fmt.Sprintf(`
+resource "aws_bedrockagent_agent_alias" "test" {
+  agent_alias_name = %[1]q
+  agent_id         = aws_bedrockagent_agent.test.agent_id
+  description      = "Test Alias"
+  routing_configuration {
+    agent_version = aws_bedrockagent_agent_version.test.agent_version
+  }
+}
+`, rName))
+}
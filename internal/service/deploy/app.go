@@ -225,6 +225,17 @@ func appParseResourceID(id string) string {
 	return parts[1]
 }
 
+// findApplicationComputePlatform returns the compute platform of the named application.
+func findApplicationComputePlatform(ctx context.Context, conn *codedeploy.Client, name string) (types.ComputePlatform, error) {
+	application, err := findApplicationByName(ctx, conn, name)
+
+	if err != nil {
+		return "", err
+	}
+
+	return application.ComputePlatform, nil
+}
+
 func findApplicationByName(ctx context.Context, conn *codedeploy.Client, name string) (*types.ApplicationInfo, error) {
 	input := &codedeploy.GetApplicationInput{
 		ApplicationName: aws.String(name),
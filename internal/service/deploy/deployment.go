@@ -0,0 +1,572 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	sdktypes "github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	deploymentPollIntervalDefault = "15s"
+	deploymentPollIntervalMin     = 5 * time.Second
+	// Longer poll intervals are ignored by the waiter in favour of its own backoff.
+	deploymentPollIntervalMax = 2 * time.Minute
+)
+
+// deploymentPendingStatuses are the statuses of a deployment that hasn't finished.
+// Ready is a blue/green deployment waiting for traffic to be rerouted.
+var deploymentPendingStatuses = []types.DeploymentStatus{
+	types.DeploymentStatusCreated,
+	types.DeploymentStatusQueued,
+	types.DeploymentStatusInProgress,
+	types.DeploymentStatusBaking,
+	types.DeploymentStatusReady,
+}
+
+// @SDKResource("aws_codedeploy_deployment", name="Deployment")
+func resourceDeployment() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDeploymentCreate,
+		ReadWithoutTimeout:   resourceDeploymentRead,
+		UpdateWithoutTimeout: resourceDeploymentUpdate,
+		DeleteWithoutTimeout: resourceDeploymentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDeploymentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"app_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"deployment_config_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, deploymentConfigNameMaxLength),
+			},
+			"deployment_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"deployment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"error_code": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"error_message": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"poll_interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          deploymentPollIntervalDefault,
+				ValidateDiagFunc: sdktypes.ValidateDurationBetween(deploymentPollIntervalMin, deploymentPollIntervalMax),
+			},
+			"revision": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_spec_content": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"revision.0.app_spec_content", "revision.0.github_location", "revision.0.s3_location"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrContent: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"sha256": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"github_location": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"revision.0.app_spec_content", "revision.0.github_location", "revision.0.s3_location"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"commit_id": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"repository": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"s3_location": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"revision.0.app_spec_content", "revision.0.github_location", "revision.0.s3_location"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrBucket: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"bundle_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[types.BundleType](),
+									},
+									"etag": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									names.AttrKey: {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrVersion: {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"rollback_info": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rollback_deployment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rollback_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rollback_triggering_deployment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeployClient(ctx)
+
+	applicationName, deploymentGroupName := d.Get("app_name").(string), d.Get("deployment_group_name").(string)
+	input := &codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(applicationName),
+		DeploymentGroupName: aws.String(deploymentGroupName),
+		Revision:            expandRevisionLocation(d.Get("revision").([]interface{})[0].(map[string]interface{})),
+	}
+
+	if v, ok := d.GetOk("deployment_config_name"); ok {
+		input.DeploymentConfigName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDeployment(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeDeploy Deployment (%s:%s): %s", applicationName, deploymentGroupName, err)
+	}
+
+	d.SetId(aws.ToString(output.DeploymentId))
+
+	pollInterval, _, err := sdktypes.Duration(d.Get("poll_interval").(string)).Value()
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "parsing poll_interval: %s", err)
+	}
+
+	if _, err := waitDeploymentSucceeded(ctx, conn, d.Id(), pollInterval, d.Timeout(schema.TimeoutCreate)); err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "waiting for CodeDeploy Deployment (%s) create: %s", d.Id(), err)
+	}
+
+	// Read even if the deployment didn't succeed so that its status, error_code and error_message are recorded.
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeployClient(ctx)
+
+	deployment, err := findDeploymentByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeDeploy Deployment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeDeploy Deployment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("app_name", deployment.ApplicationName)
+	d.Set("deployment_config_name", deployment.DeploymentConfigName)
+	d.Set("deployment_group_name", deployment.DeploymentGroupName)
+	d.Set("deployment_id", deployment.DeploymentId)
+	d.Set(names.AttrDescription, deployment.Description)
+	if v := deployment.ErrorInformation; v != nil {
+		d.Set("error_code", v.Code)
+		d.Set("error_message", v.Message)
+	} else {
+		d.Set("error_code", nil)
+		d.Set("error_message", nil)
+	}
+	if deployment.Revision != nil {
+		if err := d.Set("revision", flattenRevisionLocation(deployment.Revision)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting revision: %s", err)
+		}
+	}
+	if err := d.Set("rollback_info", flattenRollbackInfo(deployment.RollbackInfo)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rollback_info: %s", err)
+	}
+	d.Set(names.AttrStatus, deployment.Status)
+
+	return diags
+}
+
+func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Only poll_interval can change, and it only affects how the deployment is waited for.
+
+	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
+}
+
+func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeployClient(ctx)
+
+	// Deployments can't be deleted, so one that has finished is only removed from state.
+	// One still in progress, for example after the create timeout, is stopped.
+	deployment, err := findDeploymentByID(ctx, conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeDeploy Deployment (%s): %s", d.Id(), err)
+	}
+
+	if !slices.Contains(deploymentPendingStatuses, deployment.Status) {
+		return diags
+	}
+
+	log.Printf("[INFO] Stopping CodeDeploy Deployment: %s", d.Id())
+	_, err = conn.StopDeployment(ctx, &codedeploy.StopDeploymentInput{
+		DeploymentId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*types.DeploymentAlreadyCompletedException](err) || errs.IsA[*types.DeploymentDoesNotExistException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "stopping CodeDeploy Deployment (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceDeploymentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("poll_interval", deploymentPollIntervalDefault)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func findDeploymentByID(ctx context.Context, conn *codedeploy.Client, id string) (*types.DeploymentInfo, error) {
+	input := &codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	}
+
+	output, err := conn.GetDeployment(ctx, input)
+
+	if errs.IsA[*types.DeploymentDoesNotExistException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DeploymentInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.DeploymentInfo, nil
+}
+
+func statusDeployment(ctx context.Context, conn *codedeploy.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDeploymentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDeploymentSucceeded(ctx context.Context, conn *codedeploy.Client, id string, pollInterval, timeout time.Duration) (*types.DeploymentInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(deploymentPendingStatuses...),
+		Target:       enum.Slice(types.DeploymentStatusSucceeded),
+		Refresh:      statusDeployment(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DeploymentInfo); ok {
+		if v := output.ErrorInformation; v != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", v.Code, aws.ToString(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandRevisionLocation(tfMap map[string]interface{}) *types.RevisionLocation {
+	apiObject := &types.RevisionLocation{}
+
+	if v, ok := tfMap["app_spec_content"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.AppSpecContent = expandAppSpecContent(v[0].(map[string]interface{}))
+		apiObject.RevisionType = types.RevisionLocationTypeAppSpecContent
+	}
+
+	if v, ok := tfMap["github_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.GitHubLocation = expandGitHubLocation(v[0].(map[string]interface{}))
+		apiObject.RevisionType = types.RevisionLocationTypeGitHub
+	}
+
+	if v, ok := tfMap["s3_location"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.S3Location = expandS3Location(v[0].(map[string]interface{}))
+		apiObject.RevisionType = types.RevisionLocationTypeS3
+	}
+
+	return apiObject
+}
+
+func expandAppSpecContent(tfMap map[string]interface{}) *types.AppSpecContent {
+	apiObject := &types.AppSpecContent{}
+
+	if v, ok := tfMap[names.AttrContent].(string); ok && v != "" {
+		apiObject.Content = aws.String(v)
+	}
+
+	if v, ok := tfMap["sha256"].(string); ok && v != "" {
+		apiObject.Sha256 = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandGitHubLocation(tfMap map[string]interface{}) *types.GitHubLocation {
+	apiObject := &types.GitHubLocation{}
+
+	if v, ok := tfMap["commit_id"].(string); ok && v != "" {
+		apiObject.CommitId = aws.String(v)
+	}
+
+	if v, ok := tfMap["repository"].(string); ok && v != "" {
+		apiObject.Repository = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandS3Location(tfMap map[string]interface{}) *types.S3Location {
+	apiObject := &types.S3Location{}
+
+	if v, ok := tfMap[names.AttrBucket].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["bundle_type"].(string); ok && v != "" {
+		apiObject.BundleType = types.BundleType(v)
+	}
+
+	if v, ok := tfMap["etag"].(string); ok && v != "" {
+		apiObject.ETag = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrKey].(string); ok && v != "" {
+		apiObject.Key = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrVersion].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenRevisionLocation(apiObject *types.RevisionLocation) []interface{} {
+	tfList := make([]interface{}, 0)
+
+	if apiObject == nil {
+		return tfList
+	}
+
+	tfMap := map[string]interface{}{
+		"app_spec_content": flattenAppSpecContent(apiObject.AppSpecContent),
+		"github_location":  flattenGitHubLocation(apiObject.GitHubLocation),
+		"s3_location":      flattenS3Location(apiObject.S3Location),
+	}
+
+	return append(tfList, tfMap)
+}
+
+func flattenAppSpecContent(apiObject *types.AppSpecContent) []interface{} {
+	tfList := make([]interface{}, 0)
+
+	if apiObject == nil {
+		return tfList
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrContent: aws.ToString(apiObject.Content),
+		"sha256":          aws.ToString(apiObject.Sha256),
+	}
+
+	return append(tfList, tfMap)
+}
+
+func flattenGitHubLocation(apiObject *types.GitHubLocation) []interface{} {
+	tfList := make([]interface{}, 0)
+
+	if apiObject == nil {
+		return tfList
+	}
+
+	tfMap := map[string]interface{}{
+		"commit_id":  aws.ToString(apiObject.CommitId),
+		"repository": aws.ToString(apiObject.Repository),
+	}
+
+	return append(tfList, tfMap)
+}
+
+func flattenS3Location(apiObject *types.S3Location) []interface{} {
+	tfList := make([]interface{}, 0)
+
+	if apiObject == nil {
+		return tfList
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrBucket:  aws.ToString(apiObject.Bucket),
+		"bundle_type":     apiObject.BundleType,
+		"etag":            aws.ToString(apiObject.ETag),
+		names.AttrKey:     aws.ToString(apiObject.Key),
+		names.AttrVersion: aws.ToString(apiObject.Version),
+	}
+
+	return append(tfList, tfMap)
+}
+
+func flattenRollbackInfo(apiObject *types.RollbackInfo) []interface{} {
+	tfList := make([]interface{}, 0)
+
+	if apiObject == nil {
+		return tfList
+	}
+
+	tfMap := map[string]interface{}{
+		"rollback_deployment_id":            aws.ToString(apiObject.RollbackDeploymentId),
+		"rollback_message":                  aws.ToString(apiObject.RollbackMessage),
+		"rollback_triggering_deployment_id": aws.ToString(apiObject.RollbackTriggeringDeploymentId),
+	}
+
+	return append(tfList, tfMap)
+}
@@ -4,33 +4,98 @@
 package deploy
 
 import (
+	"bytes"
 	"context"
-	"log"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
 	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	deploymentConfigNameMaxLength = 100
+	// AWS-managed deployment configs use this prefix and CodeDeploy rejects custom configs that do.
+	deploymentConfigNameManagedPrefix = "CodeDeployDefault."
+	// CodeDeploy does not allow a traffic-shifting deployment to take longer than two days.
+	trafficRoutingMaxDurationInMinutes = 2880
+	// A single step that shifts 100% of traffic is an AllAtOnce deployment.
+	trafficRoutingMaxPercentage = 99
+	// Deployment groups switched to a replacement config release the old one asynchronously.
+	deploymentConfigInUseTimeout = 5 * time.Minute
+	// Deployment groups use a config with this prefix while the config they use is recreated with new settings.
+	deploymentConfigReplacementNamePrefix = "terraform-replacing-"
+	// Finding the deployment groups that use a config means scanning every group in the account, so stop after this many.
+	deploymentConfigUsedByMaxDeploymentGroups = 1000
+	// BatchGetDeploymentGroups accepts at most this many deployment group names per call.
+	deploymentGroupsBatchSize = 100
+)
+
+// trafficRoutingTypesByComputePlatform lists the traffic routing types supported by each compute platform.
+// Server deployments update instances in place and do not shift traffic.
+var trafficRoutingTypesByComputePlatform = map[types.ComputePlatform][]types.TrafficRoutingType{
+	types.ComputePlatformEcs: {
+		types.TrafficRoutingTypeAllAtOnce,
+		types.TrafficRoutingTypeTimeBasedCanary,
+		types.TrafficRoutingTypeTimeBasedLinear,
+	},
+	types.ComputePlatformLambda: {
+		types.TrafficRoutingTypeAllAtOnce,
+		types.TrafficRoutingTypeTimeBasedCanary,
+		types.TrafficRoutingTypeTimeBasedLinear,
+	},
+	types.ComputePlatformServer: {},
+}
+
+// trafficRoutingIntervalLimits is the range of time-based traffic shifting intervals, in whole minutes, that a compute platform accepts.
+type trafficRoutingIntervalLimits struct {
+	min, max int32
+}
+
+var trafficRoutingIntervalLimitsByComputePlatform = map[types.ComputePlatform]trafficRoutingIntervalLimits{
+	types.ComputePlatformEcs:    {min: 1, max: trafficRoutingMaxDurationInMinutes},
+	types.ComputePlatformLambda: {min: 1, max: trafficRoutingMaxDurationInMinutes},
+}
+
+// defaultMinimumHealthyHostsByComputePlatform is the minimum healthy hosts policy of the deployment config CodeDeploy
+// uses when a deployment group doesn't name one. Only the Server platform's default (CodeDeployDefault.OneAtATime) has one.
+var defaultMinimumHealthyHostsByComputePlatform = map[types.ComputePlatform]types.MinimumHealthyHosts{
+	types.ComputePlatformServer: {Type: types.MinimumHealthyHostsTypeFleetPercent, Value: 99},
+}
+
 // @SDKResource("aws_codedeploy_deployment_config", name="Deployment Config")
 func resourceDeploymentConfig() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDeploymentConfigCreate,
 		ReadWithoutTimeout:   resourceDeploymentConfigRead,
+		UpdateWithoutTimeout: resourceDeploymentConfigUpdate,
 		DeleteWithoutTimeout: resourceDeploymentConfigDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDeploymentConfigImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -45,60 +110,120 @@ func resourceDeploymentConfig() *schema.Resource {
 				Default:          types.ComputePlatformServer,
 				ValidateDiagFunc: enum.Validate[types.ComputePlatform](),
 			},
+			"config_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"deployment_config_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
 			"deployment_config_name": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{names.AttrNamePrefix, "name_suffix"},
 			},
-			"minimum_healthy_hosts": {
-				Type:     schema.TypeList,
+			"include_raw_api_responses": {
+				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
-				MaxItems: 1,
+				Default:  false,
+			},
+			"include_used_by_deployment_groups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"minimum_healthy_hosts": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"minimum_healthy_hosts_type", "minimum_healthy_hosts_value"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrType: {
 							Type:             schema.TypeString,
 							Optional:         true,
+							Computed:         true,
 							ForceNew:         true,
 							ValidateDiagFunc: enum.Validate[types.MinimumHealthyHostsType](),
 						},
 						names.AttrValue: {
 							Type:     schema.TypeInt,
 							Optional: true,
+							Computed: true,
 							ForceNew: true,
 						},
+						"value_string": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"minimum_healthy_hosts.0.value"},
+							ValidateFunc:  validateMinimumHealthyHostsValueString,
+						},
 					},
 				},
 			},
+			"minimum_healthy_hosts_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"minimum_healthy_hosts_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"minimum_healthy_hosts"},
+				RequiredWith:     []string{"minimum_healthy_hosts_value"},
+				ValidateDiagFunc: enum.Validate[types.MinimumHealthyHostsType](),
+			},
+			"minimum_healthy_hosts_value": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"minimum_healthy_hosts"},
+				RequiredWith:  []string{"minimum_healthy_hosts_type"},
+			},
+			names.AttrNamePrefix: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"deployment_config_name"},
+			},
+			"name_suffix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"deployment_config_name"},
+			},
+			"raw_config_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"traffic_routing_config": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"time_based_canary": {
 							Type:          schema.TypeList,
 							Optional:      true,
-							ForceNew:      true,
 							MaxItems:      1,
 							ConflictsWith: []string{"traffic_routing_config.0.time_based_linear"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrInterval: {
-										Type:     schema.TypeInt,
-										Optional: true,
-										ForceNew: true,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, trafficRoutingMaxDurationInMinutes),
 									},
 									"percentage": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										ForceNew: true,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, trafficRoutingMaxPercentage),
 									},
 								},
 							},
@@ -106,20 +231,19 @@ func resourceDeploymentConfig() *schema.Resource {
 						"time_based_linear": {
 							Type:          schema.TypeList,
 							Optional:      true,
-							ForceNew:      true,
 							MaxItems:      1,
 							ConflictsWith: []string{"traffic_routing_config.0.time_based_canary"},
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrInterval: {
-										Type:     schema.TypeInt,
-										Optional: true,
-										ForceNew: true,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, trafficRoutingMaxDurationInMinutes),
 									},
 									"percentage": {
-										Type:     schema.TypeInt,
-										Optional: true,
-										ForceNew: true,
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, trafficRoutingMaxPercentage),
 									},
 								},
 							},
@@ -127,13 +251,17 @@ func resourceDeploymentConfig() *schema.Resource {
 						names.AttrType: {
 							Type:             schema.TypeString,
 							Optional:         true,
-							ForceNew:         true,
 							Default:          types.TrafficRoutingTypeAllAtOnce,
 							ValidateDiagFunc: enum.Validate[types.TrafficRoutingType](),
 						},
 					},
 				},
 			},
+			"used_by_deployment_groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"zonal_config": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -176,6 +304,8 @@ func resourceDeploymentConfig() *schema.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: resourceDeploymentConfigCustomizeDiff,
 	}
 }
 
@@ -183,13 +313,23 @@ func resourceDeploymentConfigCreate(ctx context.Context, d *schema.ResourceData,
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DeployClient(ctx)
 
-	name := d.Get("deployment_config_name").(string)
-	input := &codedeploy.CreateDeploymentConfigInput{
-		ComputePlatform:      types.ComputePlatform(d.Get("compute_platform").(string)),
-		DeploymentConfigName: aws.String(name),
-		MinimumHealthyHosts:  expandMinimumHealthyHosts(d),
-		TrafficRoutingConfig: expandTrafficRoutingConfig(d),
-		ZonalConfig:          expandZonalConfig(d),
+	input := expandCreateDeploymentConfigInput(d)
+	name := aws.ToString(input.DeploymentConfigName)
+
+	if err := validateCreateDeploymentConfigInput(input); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeDeploy Deployment Config (%s): %s", name, err)
+	}
+
+	if zonalConfigMissingMinimumHealthyHostsPerZone(input.ZonalConfig) {
+		diags = sdkdiag.AppendWarningf(diags, "CodeDeploy Deployment Config (%s): zonal_config sets monitor durations without minimum_healthy_hosts_per_zone; CodeDeploy will require 0 percent of instances per Availability Zone to remain healthy", name)
+	}
+
+	if minimumHealthyHostsWithPerZone(input) {
+		diags = sdkdiag.AppendWarningf(diags, "CodeDeploy Deployment Config (%s): both minimum_healthy_hosts and zonal_config.minimum_healthy_hosts_per_zone are set; CodeDeploy enforces both, so whichever allows fewer instances to be deployed at once limits each Availability Zone", name)
+	}
+
+	if v, ok := timeBasedLinearPartialLastStep(input.TrafficRoutingConfig); ok {
+		diags = sdkdiag.AppendWarningf(diags, "CodeDeploy Deployment Config (%s): traffic_routing_config.time_based_linear.percentage (%d) does not divide 100 evenly; the last increment will shift only the remaining %d percent of traffic", name, input.TrafficRoutingConfig.TimeBasedLinear.LinearPercentage, v)
 	}
 
 	_, err := conn.CreateDeploymentConfig(ctx, input)
@@ -210,7 +350,9 @@ func resourceDeploymentConfigRead(ctx context.Context, d *schema.ResourceData, m
 	deploymentConfig, err := findDeploymentConfigByName(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
-		log.Printf("[WARN] CodeDeploy Deployment Config (%s) not found, removing from state", d.Id())
+		tflog.Warn(ctx, "CodeDeploy Deployment Config not found, removing from state", map[string]any{
+			"deployment_config_name": d.Id(),
+		})
 		d.SetId("")
 		return diags
 	}
@@ -220,44 +362,403 @@ func resourceDeploymentConfigRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	deploymentConfigName := aws.ToString(deploymentConfig.DeploymentConfigName)
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition(ctx),
-		Service:   "codedeploy",
-		Region:    meta.(*conns.AWSClient).Region(ctx),
-		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
-		Resource:  "deploymentconfig:" + deploymentConfigName,
-	}.String()
-	d.Set(names.AttrARN, arn)
-	d.Set("compute_platform", deploymentConfig.ComputePlatform)
-	d.Set("deployment_config_id", deploymentConfig.DeploymentConfigId)
-	d.Set("deployment_config_name", deploymentConfigName)
-	if err := d.Set("minimum_healthy_hosts", flattenMinimumHealthHosts(deploymentConfig.MinimumHealthyHosts)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting minimum_healthy_hosts: %s", err)
-	}
-	if err := d.Set("traffic_routing_config", flattenTrafficRoutingConfig(deploymentConfig.TrafficRoutingConfig)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting traffic_routing_config: %s", err)
-	}
-	if err := d.Set("zonal_config", flattenZonalConfig(deploymentConfig.ZonalConfig)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting zonal_config: %s", err)
+	d.Set(names.AttrARN, deploymentConfigARN(ctx, meta.(*conns.AWSClient), deploymentConfigName))
+	if err := setDeploymentConfigResourceData(ctx, d, deploymentConfig); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	if err := setDeploymentConfigRawJSON(d, deploymentConfig, d.Get("include_raw_api_responses").(bool)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	if d.Get("include_used_by_deployment_groups").(bool) {
+		usedBy, err := findDeploymentGroupIDsByDeploymentConfigName(ctx, conn, deploymentConfigName, deploymentConfigUsedByMaxDeploymentGroups)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading CodeDeploy Deployment Config (%s) deployment groups: %s", d.Id(), err)
+		}
+
+		d.Set("used_by_deployment_groups", usedBy)
+	} else {
+		d.Set("used_by_deployment_groups", nil)
 	}
+	d.Set(names.AttrNamePrefix, create.NamePrefixFromNameWithSuffix(deploymentConfigName, d.Get("name_suffix").(string)))
 
 	return diags
 }
 
+func setDeploymentConfigResourceData(ctx context.Context, d *schema.ResourceData, apiObject *types.DeploymentConfigInfo) error {
+	// Some AWS-managed deployment configs are returned without a compute platform.
+	// Leave any existing value in place rather than setting an empty string that causes diffs.
+	if v := apiObject.ComputePlatform; v != "" {
+		d.Set("compute_platform", v)
+	} else {
+		tflog.Warn(ctx, "CodeDeploy Deployment Config returned no compute platform, leaving compute_platform unchanged", map[string]any{
+			"deployment_config_name": d.Id(),
+		})
+	}
+	d.Set("deployment_config_id", apiObject.DeploymentConfigId)
+	d.Set("deployment_config_name", apiObject.DeploymentConfigName)
+	// Mirror whichever form was configured so that neither form shows a diff.
+	if _, ok := d.GetOk("minimum_healthy_hosts_type"); ok && apiObject.MinimumHealthyHosts != nil {
+		d.Set("minimum_healthy_hosts_type", apiObject.MinimumHealthyHosts.Type)
+		d.Set("minimum_healthy_hosts_value", apiObject.MinimumHealthyHosts.Value)
+	} else {
+		tfList := flattenMinimumHealthHosts(apiObject.MinimumHealthyHosts)
+		if v := d.Get("minimum_healthy_hosts.0.value_string").(string); v != "" && len(tfList) > 0 {
+			tfList[0].(map[string]interface{})["value_string"] = formatMinimumHealthyHostsValueString(apiObject.MinimumHealthyHosts)
+		}
+		if err := d.Set("minimum_healthy_hosts", tfList); err != nil {
+			return fmt.Errorf("setting minimum_healthy_hosts: %w", err)
+		}
+	}
+	d.Set("minimum_healthy_hosts_description", minimumHealthyHostsDescription(apiObject.MinimumHealthyHosts))
+	if err := d.Set("traffic_routing_config", flattenTrafficRoutingConfig(apiObject.TrafficRoutingConfig)); err != nil {
+		return fmt.Errorf("setting traffic_routing_config: %w", err)
+	}
+	if err := d.Set("zonal_config", flattenZonalConfig(apiObject.ZonalConfig)); err != nil {
+		return fmt.Errorf("setting zonal_config: %w", err)
+	}
+	configJSON, err := flattenDeploymentConfigJSON(d.Get("compute_platform").(string), apiObject)
+	if err != nil {
+		return fmt.Errorf("setting config_json: %w", err)
+	}
+	d.Set("config_json", configJSON)
+
+	return nil
+}
+
+// flattenDeploymentConfigJSON serializes the effective configuration using the same shape as the resource's arguments.
+func flattenDeploymentConfigJSON(computePlatform string, apiObject *types.DeploymentConfigInfo) (string, error) {
+	tfMap := map[string]interface{}{
+		"compute_platform":       computePlatform,
+		"minimum_healthy_hosts":  flattenMinimumHealthHosts(apiObject.MinimumHealthyHosts),
+		"traffic_routing_config": flattenTrafficRoutingConfig(apiObject.TrafficRoutingConfig),
+		"zonal_config":           flattenZonalConfig(apiObject.ZonalConfig),
+	}
+
+	b, err := json.Marshal(tfMap)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// setDeploymentConfigRawJSON stores the API response as returned by CodeDeploy when include_raw_api_responses is set.
+// Otherwise the attribute is cleared so that turning the argument off shrinks state again.
+func setDeploymentConfigRawJSON(d *schema.ResourceData, apiObject *types.DeploymentConfigInfo, enabled bool) error {
+	if !enabled {
+		d.Set("raw_config_json", "")
+		return nil
+	}
+
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return fmt.Errorf("setting raw_config_json: %w", err)
+	}
+
+	d.Set("raw_config_json", string(b))
+
+	return nil
+}
+
+func resourceDeploymentConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeployClient(ctx)
+
+	if o, n := d.GetChange("traffic_routing_config"); trafficRoutingConfigChanged(o.([]interface{}), n.([]interface{})) {
+		input := expandCreateDeploymentConfigInput(d)
+		input.DeploymentConfigName = aws.String(d.Id())
+
+		if err := validateCreateDeploymentConfigInput(input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeDeploy Deployment Config (%s): %s", d.Id(), err)
+		}
+
+		if err := replaceDeploymentConfig(ctx, conn, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeDeploy Deployment Config (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDeploymentConfigRead(ctx, d, meta)...)
+}
+
 func resourceDeploymentConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DeployClient(ctx)
 
-	log.Printf("[INFO] Deleting CodeDeploy Deployment Config: %s", d.Id())
-	_, err := conn.DeleteDeploymentConfig(ctx, &codedeploy.DeleteDeploymentConfigInput{
-		DeploymentConfigName: aws.String(d.Id()),
+	if err := deleteDeploymentConfig(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func deleteDeploymentConfig(ctx context.Context, conn *codedeploy.Client, name string) error {
+	tflog.Info(ctx, "Deleting CodeDeploy Deployment Config", map[string]any{
+		"deployment_config_name": name,
+	})
+	_, err := tfresource.RetryWhenIsA[*types.DeploymentConfigInUseException](ctx, deploymentConfigInUseTimeout, func() (interface{}, error) {
+		return conn.DeleteDeploymentConfig(ctx, &codedeploy.DeleteDeploymentConfigInput{
+			DeploymentConfigName: aws.String(name),
+		})
 	})
 
+	if errs.IsA[*types.DeploymentConfigDoesNotExistException](err) {
+		return nil
+	}
+
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting CodeDeploy Deployment Config (%s): %s", d.Id(), err)
+		return fmt.Errorf("deleting CodeDeploy Deployment Config (%s): %w", name, err)
 	}
 
-	return diags
+	return nil
+}
+
+// replaceDeploymentConfig recreates a deployment config with new settings, as CodeDeploy cannot update one in place.
+// A temporary copy with the new settings is created before the config is deleted, and deployment groups that use the config
+// are switched to the copy while it is recreated, so that they never reference a config that doesn't exist.
+// If a step fails, the config is restored and the deployment groups are switched back to it before the copy is deleted.
+func replaceDeploymentConfig(ctx context.Context, conn *codedeploy.Client, input *codedeploy.CreateDeploymentConfigInput) error {
+	name := aws.ToString(input.DeploymentConfigName)
+
+	deploymentConfig, err := findDeploymentConfigByName(ctx, conn, name)
+
+	if err != nil {
+		return fmt.Errorf("reading CodeDeploy Deployment Config (%s): %w", name, err)
+	}
+
+	// Every deployment group that uses the config must be switched to the copy, however many groups there are.
+	deploymentGroups, err := findDeploymentGroupsByDeploymentConfigName(ctx, conn, name, 0)
+
+	if err != nil {
+		return err
+	}
+
+	replacementName := id.PrefixedUniqueId(deploymentConfigReplacementNamePrefix)
+	replacementInput := *input
+	replacementInput.DeploymentConfigName = aws.String(replacementName)
+
+	if _, err := conn.CreateDeploymentConfig(ctx, &replacementInput); err != nil {
+		return fmt.Errorf("creating CodeDeploy Deployment Config (%s): %w", replacementName, err)
+	}
+
+	if updated, err := updateDeploymentGroupsDeploymentConfigName(ctx, conn, deploymentGroups, replacementName); err != nil {
+		return rollBackDeploymentConfigReplacement(ctx, conn, name, replacementName, updated, err)
+	}
+
+	if err := deleteDeploymentConfig(ctx, conn, name); err != nil {
+		return rollBackDeploymentConfigReplacement(ctx, conn, name, replacementName, deploymentGroups, err)
+	}
+
+	if _, err := conn.CreateDeploymentConfig(ctx, input); err != nil {
+		err = fmt.Errorf("creating CodeDeploy Deployment Config (%s): %w", name, err)
+
+		if _, restoreErr := conn.CreateDeploymentConfig(ctx, createDeploymentConfigInputFromDeploymentConfigInfo(deploymentConfig)); restoreErr != nil {
+			return errors.Join(err, fmt.Errorf("restoring CodeDeploy Deployment Config (%s): %w; deployment groups use CodeDeploy Deployment Config (%s)", name, restoreErr, replacementName))
+		}
+
+		return rollBackDeploymentConfigReplacement(ctx, conn, name, replacementName, deploymentGroups, err)
+	}
+
+	if updated, err := updateDeploymentGroupsDeploymentConfigName(ctx, conn, deploymentGroups, name); err != nil {
+		return rollBackDeploymentConfigReplacement(ctx, conn, name, replacementName, deploymentGroups[len(updated):], err)
+	}
+
+	return deleteDeploymentConfig(ctx, conn, replacementName)
+}
+
+// rollBackDeploymentConfigReplacement switches deployment groups from the temporary copy made by replaceDeploymentConfig
+// back to the deployment config and deletes the copy. err, which stopped the replacement, is returned along with any
+// error from rolling back.
+func rollBackDeploymentConfigReplacement(ctx context.Context, conn *codedeploy.Client, name, replacementName string, deploymentGroups []types.DeploymentGroupInfo, err error) error {
+	if _, rollbackErr := updateDeploymentGroupsDeploymentConfigName(ctx, conn, deploymentGroups, name); rollbackErr != nil {
+		return errors.Join(err, fmt.Errorf("%w; CodeDeploy Deployment Config (%s) is still in use", rollbackErr, replacementName))
+	}
+
+	if rollbackErr := deleteDeploymentConfig(ctx, conn, replacementName); rollbackErr != nil {
+		return errors.Join(err, rollbackErr)
+	}
+
+	return err
+}
+
+// updateDeploymentGroupsDeploymentConfigName switches each deployment group to the named deployment config.
+// The deployment groups switched before any error are returned.
+func updateDeploymentGroupsDeploymentConfigName(ctx context.Context, conn *codedeploy.Client, deploymentGroups []types.DeploymentGroupInfo, deploymentConfigName string) ([]types.DeploymentGroupInfo, error) {
+	for i := range deploymentGroups {
+		v := &deploymentGroups[i]
+		input := updateDeploymentGroupInputFromDeploymentGroupInfo(v)
+		input.DeploymentConfigName = aws.String(deploymentConfigName)
+
+		if _, err := conn.UpdateDeploymentGroup(ctx, input); err != nil {
+			return deploymentGroups[:i], fmt.Errorf("updating CodeDeploy Deployment Group (%s:%s): %w", aws.ToString(v.ApplicationName), aws.ToString(v.DeploymentGroupName), err)
+		}
+	}
+
+	return deploymentGroups, nil
+}
+
+// updateDeploymentGroupInputFromDeploymentGroupInfo returns the input that updates a deployment group to its current settings.
+// Every setting is sent, as for resourceDeploymentGroupUpdate, so that none is cleared.
+func updateDeploymentGroupInputFromDeploymentGroupInfo(apiObject *types.DeploymentGroupInfo) *codedeploy.UpdateDeploymentGroupInput {
+	input := &codedeploy.UpdateDeploymentGroupInput{
+		AlarmConfiguration:               apiObject.AlarmConfiguration,
+		ApplicationName:                  apiObject.ApplicationName,
+		AutoRollbackConfiguration:        apiObject.AutoRollbackConfiguration,
+		BlueGreenDeploymentConfiguration: apiObject.BlueGreenDeploymentConfiguration,
+		CurrentDeploymentGroupName:       apiObject.DeploymentGroupName,
+		DeploymentConfigName:             apiObject.DeploymentConfigName,
+		DeploymentStyle:                  apiObject.DeploymentStyle,
+		Ec2TagFilters:                    apiObject.Ec2TagFilters,
+		Ec2TagSet:                        apiObject.Ec2TagSet,
+		EcsServices:                      apiObject.EcsServices,
+		LoadBalancerInfo:                 apiObject.LoadBalancerInfo,
+		OnPremisesInstanceTagFilters:     apiObject.OnPremisesInstanceTagFilters,
+		OnPremisesTagSet:                 apiObject.OnPremisesTagSet,
+		OutdatedInstancesStrategy:        apiObject.OutdatedInstancesStrategy,
+		ServiceRoleArn:                   apiObject.ServiceRoleArn,
+		TriggerConfigurations:            apiObject.TriggerConfigurations,
+	}
+
+	for _, v := range apiObject.AutoScalingGroups {
+		input.AutoScalingGroups = append(input.AutoScalingGroups, aws.ToString(v.Name))
+	}
+
+	if apiObject.TerminationHookEnabled {
+		input.TerminationHookEnabled = aws.Bool(true)
+	}
+
+	return input
+}
+
+// createDeploymentConfigInputFromDeploymentConfigInfo returns the input that creates a deployment config with the same settings.
+func createDeploymentConfigInputFromDeploymentConfigInfo(apiObject *types.DeploymentConfigInfo) *codedeploy.CreateDeploymentConfigInput {
+	return &codedeploy.CreateDeploymentConfigInput{
+		ComputePlatform:      apiObject.ComputePlatform,
+		DeploymentConfigName: apiObject.DeploymentConfigName,
+		MinimumHealthyHosts:  apiObject.MinimumHealthyHosts,
+		TrafficRoutingConfig: apiObject.TrafficRoutingConfig,
+		ZonalConfig:          apiObject.ZonalConfig,
+	}
+}
+
+func resourceDeploymentConfigCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		// Create.
+		name := deploymentConfigName(diff)
+
+		if err := validateDeploymentConfigNameLength(name); err != nil {
+			return fmt.Errorf("%w; shorten name_prefix or name_suffix", err)
+		}
+
+		if err := validateDeploymentConfigNameNotManaged(name); err != nil {
+			return err
+		}
+	} else if diff.HasChange("traffic_routing_config") {
+		// Update recreates the config.
+		for _, k := range []string{"config_json", "deployment_config_id", "raw_config_json"} {
+			if err := diff.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := validateMinimumHealthyHostsValueStringType(diff.GetRawConfig().GetAttr("minimum_healthy_hosts")); err != nil {
+		return err
+	}
+
+	if err := validateTrafficRoutingTypeConfigured(diff.GetRawConfig().GetAttr("traffic_routing_config")); err != nil {
+		return err
+	}
+
+	if v := diff.GetRawConfig().GetAttr("traffic_routing_config"); v.IsWhollyKnown() && !v.IsNull() && v.LengthInt() > 0 {
+		apiObject := expandTrafficRoutingConfig(diff)
+
+		if err := validateTrafficRoutingConsistency(apiObject); err != nil {
+			return err
+		}
+
+		if diff.NewValueKnown("compute_platform") {
+			computePlatform := types.ComputePlatform(diff.Get("compute_platform").(string))
+
+			if err := validateTrafficRoutingTypeForComputePlatform(computePlatform, apiObject.Type); err != nil {
+				return err
+			}
+
+			if err := validateTrafficRoutingIntervalForComputePlatform(computePlatform, apiObject); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v := diff.GetRawConfig().GetAttr("zonal_config"); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 && diff.NewValueKnown("compute_platform") {
+		if err := validateZonalConfigForComputePlatform(types.ComputePlatform(diff.Get("compute_platform").(string))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceDeploymentConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	name, err := deploymentConfigNameFromNameOrARN(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	conn := meta.(*conns.AWSClient).DeployClient(ctx)
+
+	// Populate every attribute up front so that a missing config fails the import
+	// and generated configuration includes all nested blocks.
+	deploymentConfig, err := findDeploymentConfigByName(ctx, conn, name)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading CodeDeploy Deployment Config (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+	d.Set("include_raw_api_responses", false)
+	d.Set("include_used_by_deployment_groups", false)
+
+	if err := setDeploymentConfigResourceData(ctx, d, deploymentConfig); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// deploymentConfigARN returns the ARN of the named deployment config in the caller's account and Region.
+// The account ID is the one resolved from the caller's identity, so it reflects any assumed role.
+func deploymentConfigARN(ctx context.Context, c *conns.AWSClient, name string) string {
+	return c.RegionalARN(ctx, "codedeploy", "deploymentconfig:"+name)
+}
+
+// deploymentConfigNameFromNameOrARN returns the deployment config name from either a name or an ARN.
+// Used for import IDs and for deployment group references; ARNs may be in any partition.
+func deploymentConfigNameFromNameOrARN(id string) (string, error) {
+	if !arn.IsARN(id) {
+		return id, nil
+	}
+
+	v, err := arn.Parse(id)
+
+	if err != nil {
+		return "", fmt.Errorf("parsing CodeDeploy Deployment Config ARN (%s): %w", id, err)
+	}
+
+	if v.Service != "codedeploy" {
+		return "", fmt.Errorf("expected CodeDeploy Deployment Config ARN, received service %q: %s", v.Service, id)
+	}
+
+	name, ok := strings.CutPrefix(v.Resource, "deploymentconfig:")
+
+	if !ok || name == "" {
+		return "", fmt.Errorf("expected CodeDeploy Deployment Config ARN resource in format deploymentconfig:DeploymentConfigName, received: %s", v.Resource)
+	}
+
+	return name, nil
 }
 
 func findDeploymentConfigByName(ctx context.Context, conn *codedeploy.Client, name string) (*types.DeploymentConfigInfo, error) {
@@ -285,7 +786,433 @@ func findDeploymentConfigByName(ctx context.Context, conn *codedeploy.Client, na
 	return output.DeploymentConfigInfo, nil
 }
 
-func expandMinimumHealthyHosts(d *schema.ResourceData) *types.MinimumHealthyHosts {
+// findDeploymentGroupIDsByDeploymentConfigName returns the IDs (application:group) of the deployment groups
+// that use the named deployment config. At most maxGroups deployment groups are examined, or all of them if maxGroups is 0.
+func findDeploymentGroupIDsByDeploymentConfigName(ctx context.Context, conn *codedeploy.Client, deploymentConfigName string, maxGroups int) ([]string, error) {
+	deploymentGroups, err := findDeploymentGroupsByDeploymentConfigName(ctx, conn, deploymentConfigName, maxGroups)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output []string
+	for _, v := range deploymentGroups {
+		output = append(output, aws.ToString(v.ApplicationName)+":"+aws.ToString(v.DeploymentGroupName))
+	}
+
+	return output, nil
+}
+
+// findDeploymentGroupsByDeploymentConfigName returns the deployment groups that use the named deployment config.
+// At most maxGroups deployment groups are examined, or all of them if maxGroups is 0.
+func findDeploymentGroupsByDeploymentConfigName(ctx context.Context, conn *codedeploy.Client, deploymentConfigName string, maxGroups int) ([]types.DeploymentGroupInfo, error) {
+	var output []types.DeploymentGroupInfo
+	var scanned int
+
+	pages := codedeploy.NewListApplicationsPaginator(conn, &codedeploy.ListApplicationsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("listing CodeDeploy Applications: %w", err)
+		}
+
+		for _, applicationName := range page.Applications {
+			deploymentGroupNames, err := findDeploymentGroupNamesByApplicationName(ctx, conn, applicationName)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for chunk := range slices.Chunk(deploymentGroupNames, deploymentGroupsBatchSize) {
+				if maxGroups > 0 && scanned+len(chunk) > maxGroups {
+					chunk = chunk[:maxGroups-scanned]
+				}
+
+				if len(chunk) > 0 {
+					input := &codedeploy.BatchGetDeploymentGroupsInput{
+						ApplicationName:      aws.String(applicationName),
+						DeploymentGroupNames: chunk,
+					}
+
+					batch, err := conn.BatchGetDeploymentGroups(ctx, input)
+
+					if err != nil {
+						return nil, fmt.Errorf("reading CodeDeploy Deployment Groups (%s): %w", applicationName, err)
+					}
+
+					for _, v := range batch.DeploymentGroupsInfo {
+						if aws.ToString(v.DeploymentConfigName) == deploymentConfigName {
+							v.ApplicationName = aws.String(applicationName)
+							output = append(output, v)
+						}
+					}
+				}
+
+				scanned += len(chunk)
+
+				if maxGroups > 0 && scanned >= maxGroups {
+					tflog.Warn(ctx, "Stopped looking for CodeDeploy Deployment Groups using Deployment Config", map[string]any{
+						"deployment_config_name": deploymentConfigName,
+						"deployment_groups":      scanned,
+					})
+					return output, nil
+				}
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func findDeploymentGroupNamesByApplicationName(ctx context.Context, conn *codedeploy.Client, applicationName string) ([]string, error) {
+	var output []string
+
+	pages := codedeploy.NewListDeploymentGroupsPaginator(conn, &codedeploy.ListDeploymentGroupsInput{
+		ApplicationName: aws.String(applicationName),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		// The application was deleted while listing.
+		if errs.IsA[*types.ApplicationDoesNotExistException](err) {
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("listing CodeDeploy Deployment Groups (%s): %w", applicationName, err)
+		}
+
+		output = append(output, page.DeploymentGroups...)
+	}
+
+	return output, nil
+}
+
+// validateDeploymentConfigNameLength checks the name against the CodeDeploy limit, which counts characters rather than bytes.
+func validateDeploymentConfigNameLength(name string) error {
+	if n := utf8.RuneCountInString(name); n > deploymentConfigNameMaxLength {
+		return fmt.Errorf("deployment config name (%s) must be at most %d characters, got %d (%d bytes)", name, deploymentConfigNameMaxLength, n, len(name))
+	}
+
+	return nil
+}
+
+// defaultMinimumHealthyHosts returns the compute platform's default minimum healthy hosts policy, or nil if it has none.
+func defaultMinimumHealthyHosts(computePlatform types.ComputePlatform) *types.MinimumHealthyHosts {
+	if v, ok := defaultMinimumHealthyHostsByComputePlatform[computePlatform]; ok {
+		return &v
+	}
+
+	return nil
+}
+
+func validateDeploymentConfigNameNotManaged(name string) error {
+	if strings.HasPrefix(name, deploymentConfigNameManagedPrefix) {
+		return fmt.Errorf("deployment config name (%s) must not begin with %q, which is reserved for AWS-managed deployment configs", name, deploymentConfigNameManagedPrefix)
+	}
+
+	return nil
+}
+
+// zonalConfigMissingMinimumHealthyHostsPerZone returns whether a zonal configuration is likely incomplete.
+// CodeDeploy accepts it, but defaults minimum_healthy_hosts_per_zone to 0 percent.
+func zonalConfigMissingMinimumHealthyHostsPerZone(apiObject *types.ZonalConfig) bool {
+	if apiObject == nil || apiObject.MinimumHealthyHostsPerZone != nil {
+		return false
+	}
+
+	return aws.ToInt64(apiObject.FirstZoneMonitorDurationInSeconds) > 0 || aws.ToInt64(apiObject.MonitorDurationInSeconds) > 0
+}
+
+// minimumHealthyHostsWithPerZone returns whether both the deployment-wide and per-zone minimum healthy hosts are set.
+// CodeDeploy accepts the combination, but users often expect the per-zone value to replace the deployment-wide one.
+func minimumHealthyHostsWithPerZone(input *codedeploy.CreateDeploymentConfigInput) bool {
+	return input.MinimumHealthyHosts != nil && input.ZonalConfig != nil && input.ZonalConfig.MinimumHealthyHostsPerZone != nil
+}
+
+// timeBasedLinearPartialLastStep returns the percentage of traffic shifted by the last increment
+// of a linear configuration whose percentage does not divide 100 evenly.
+// CodeDeploy accepts such a configuration, but the final increment is smaller than the others.
+func timeBasedLinearPartialLastStep(apiObject *types.TrafficRoutingConfig) (int32, bool) {
+	if apiObject == nil || apiObject.Type != types.TrafficRoutingTypeTimeBasedLinear || apiObject.TimeBasedLinear == nil {
+		return 0, false
+	}
+
+	percentage := apiObject.TimeBasedLinear.LinearPercentage
+
+	if percentage <= 0 || 100%percentage == 0 {
+		return 0, false
+	}
+
+	return 100 % percentage, true
+}
+
+// validateTrafficRoutingTypeForComputePlatform returns an error listing the allowed traffic routing types
+// if the compute platform does not support the given type.
+// Unrecognized compute platforms are left to the enum validation.
+func validateTrafficRoutingTypeForComputePlatform(computePlatform types.ComputePlatform, typ types.TrafficRoutingType) error {
+	allowed, ok := trafficRoutingTypesByComputePlatform[computePlatform]
+
+	if !ok || slices.Contains(allowed, typ) {
+		return nil
+	}
+
+	if len(allowed) == 0 {
+		return fmt.Errorf("traffic_routing_config is not supported for the %s compute platform", computePlatform)
+	}
+
+	return fmt.Errorf("traffic_routing_config.type %s is not supported for the %s compute platform, must be one of %v", typ, computePlatform, allowed)
+}
+
+// validateMinimumHealthyHostsValueStringType returns an error if minimum_healthy_hosts sets both type and a value_string
+// that implies a different type.
+// type is Computed, so the configuration is checked rather than the planned value.
+func validateMinimumHealthyHostsValueStringType(config cty.Value) error {
+	if !config.IsKnown() || config.IsNull() || config.LengthInt() == 0 {
+		return nil
+	}
+
+	tfMap := config.Index(cty.NumberIntVal(0))
+	if !tfMap.IsKnown() || tfMap.IsNull() {
+		return nil
+	}
+
+	configuredType, valueString := tfMap.GetAttr(names.AttrType), tfMap.GetAttr("value_string")
+
+	if configuredType.IsKnown() && !configuredType.IsNull() && valueString.IsKnown() && !valueString.IsNull() {
+		if typ, _, err := parseMinimumHealthyHostsValueString(valueString.AsString()); err == nil && types.MinimumHealthyHostsType(configuredType.AsString()) != typ {
+			return fmt.Errorf("minimum_healthy_hosts.0.value_string (%s) implies type %s, which conflicts with the configured type %s", valueString.AsString(), typ, configuredType.AsString())
+		}
+	}
+
+	return nil
+}
+
+// validateTrafficRoutingTypeConfigured returns an error if a time-based block is configured without an explicit type,
+// or if a time-based type is configured without its block.
+// type defaults to AllAtOnce, which would otherwise conflict with the block.
+// Only the type and the blocks' presence need to be known, so this also runs when interval or percentage is unknown.
+func validateTrafficRoutingTypeConfigured(config cty.Value) error {
+	if !config.IsKnown() || config.IsNull() || config.LengthInt() == 0 {
+		return nil
+	}
+
+	tfMap := config.Index(cty.NumberIntVal(0))
+	if !tfMap.IsKnown() || tfMap.IsNull() {
+		return nil
+	}
+
+	blocks := map[types.TrafficRoutingType]string{
+		types.TrafficRoutingTypeTimeBasedCanary: "time_based_canary",
+		types.TrafficRoutingTypeTimeBasedLinear: "time_based_linear",
+	}
+
+	typ := tfMap.GetAttr(names.AttrType)
+	if !typ.IsKnown() {
+		return nil
+	}
+
+	if !typ.IsNull() {
+		if block, ok := blocks[types.TrafficRoutingType(typ.AsString())]; ok {
+			if v := tfMap.GetAttr(block); v.IsKnown() && (v.IsNull() || v.LengthInt() == 0) {
+				return fmt.Errorf("traffic_routing_config.%s is required when type is %s", block, typ.AsString())
+			}
+		}
+
+		return nil
+	}
+
+	for _, block := range []string{"time_based_canary", "time_based_linear"} {
+		if v := tfMap.GetAttr(block); v.IsKnown() && !v.IsNull() && v.LengthInt() > 0 {
+			return fmt.Errorf("traffic_routing_config.type is required when traffic_routing_config.%s is set", block)
+		}
+	}
+
+	return nil
+}
+
+// validateZonalConfigForComputePlatform returns an error if the compute platform does not support zonal_config.
+// Zonal deployments only apply to EC2/on-premises (Server) deployments.
+func validateZonalConfigForComputePlatform(computePlatform types.ComputePlatform) error {
+	if computePlatform == types.ComputePlatformServer {
+		return nil
+	}
+
+	return fmt.Errorf("zonal_config is not supported for the %s compute platform; remove zonal_config or use the %s compute platform", computePlatform, types.ComputePlatformServer)
+}
+
+// validateTrafficRoutingIntervalForComputePlatform returns an error naming the compute platform's limits
+// if a time-based interval is outside them.
+// Compute platforms without traffic shifting are left to validateTrafficRoutingTypeForComputePlatform.
+func validateTrafficRoutingIntervalForComputePlatform(computePlatform types.ComputePlatform, apiObject *types.TrafficRoutingConfig) error {
+	limits, ok := trafficRoutingIntervalLimitsByComputePlatform[computePlatform]
+
+	if !ok || apiObject == nil {
+		return nil
+	}
+
+	var failures []error
+
+	if v := apiObject.TimeBasedCanary; v != nil && (v.CanaryInterval < limits.min || v.CanaryInterval > limits.max) {
+		failures = append(failures, fmt.Errorf("traffic_routing_config.time_based_canary.interval must be between %d and %d minutes for the %s compute platform, got %d", limits.min, limits.max, computePlatform, v.CanaryInterval))
+	}
+	if v := apiObject.TimeBasedLinear; v != nil && (v.LinearInterval < limits.min || v.LinearInterval > limits.max) {
+		failures = append(failures, fmt.Errorf("traffic_routing_config.time_based_linear.interval must be between %d and %d minutes for the %s compute platform, got %d", limits.min, limits.max, computePlatform, v.LinearInterval))
+	}
+
+	return errors.Join(failures...)
+}
+
+// validateTrafficRoutingConsistency checks that a traffic routing config's type agrees with its
+// time-based sub-block and that the interval and percentage are within CodeDeploy's bounds.
+func validateTrafficRoutingConsistency(apiObject *types.TrafficRoutingConfig) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	var failures []error
+
+	if !slices.Contains(enum.EnumValues[types.TrafficRoutingType](), apiObject.Type) {
+		failures = append(failures, fmt.Errorf("traffic_routing_config.type must be one of %v, got %q", enum.Values[types.TrafficRoutingType](), apiObject.Type))
+	}
+	if apiObject.Type == types.TrafficRoutingTypeTimeBasedCanary && apiObject.TimeBasedCanary == nil {
+		failures = append(failures, fmt.Errorf("traffic_routing_config.time_based_canary is required when type is %s", apiObject.Type))
+	}
+	if apiObject.Type == types.TrafficRoutingTypeTimeBasedLinear && apiObject.TimeBasedLinear == nil {
+		failures = append(failures, fmt.Errorf("traffic_routing_config.time_based_linear is required when type is %s", apiObject.Type))
+	}
+	if apiObject.Type != types.TrafficRoutingTypeTimeBasedCanary && apiObject.TimeBasedCanary != nil {
+		failures = append(failures, fmt.Errorf("traffic_routing_config.time_based_canary is not supported when type is %s", apiObject.Type))
+	}
+	if apiObject.Type != types.TrafficRoutingTypeTimeBasedLinear && apiObject.TimeBasedLinear != nil {
+		failures = append(failures, fmt.Errorf("traffic_routing_config.time_based_linear is not supported when type is %s", apiObject.Type))
+	}
+	if v := apiObject.TimeBasedCanary; v != nil {
+		if v.CanaryInterval < 1 || v.CanaryInterval > trafficRoutingMaxDurationInMinutes {
+			failures = append(failures, fmt.Errorf("traffic_routing_config.time_based_canary.interval must be between 1 and %d minutes, got %d", trafficRoutingMaxDurationInMinutes, v.CanaryInterval))
+		}
+		if v.CanaryPercentage < 1 || v.CanaryPercentage > trafficRoutingMaxPercentage {
+			failures = append(failures, fmt.Errorf("traffic_routing_config.time_based_canary.percentage must be between 1 and %d, got %d", trafficRoutingMaxPercentage, v.CanaryPercentage))
+		}
+	}
+	if v := apiObject.TimeBasedLinear; v != nil {
+		if v.LinearInterval < 1 || v.LinearInterval > trafficRoutingMaxDurationInMinutes {
+			failures = append(failures, fmt.Errorf("traffic_routing_config.time_based_linear.interval must be between 1 and %d minutes, got %d", trafficRoutingMaxDurationInMinutes, v.LinearInterval))
+		}
+		if v.LinearPercentage < 1 || v.LinearPercentage > trafficRoutingMaxPercentage {
+			failures = append(failures, fmt.Errorf("traffic_routing_config.time_based_linear.percentage must be between 1 and %d, got %d", trafficRoutingMaxPercentage, v.LinearPercentage))
+		} else if v.LinearInterval >= 1 {
+			// Traffic is shifted in ceil(100/percentage) steps with one interval between each.
+			steps := (100 + v.LinearPercentage - 1) / v.LinearPercentage
+			if duration := int64(steps-1) * int64(v.LinearInterval); duration > trafficRoutingMaxDurationInMinutes {
+				failures = append(failures, fmt.Errorf("traffic_routing_config.time_based_linear shifts traffic in %d steps of %d%% every %d minutes, taking %d minutes; the maximum is %d", steps, v.LinearPercentage, v.LinearInterval, duration, trafficRoutingMaxDurationInMinutes))
+			}
+		}
+	}
+
+	return errors.Join(failures...)
+}
+
+// validateCreateDeploymentConfigInput performs client-side validation of a CreateDeploymentConfig request.
+// CodeDeploy has no dry-run API, so this mirrors the resource schema and the service's documented constraints.
+func validateCreateDeploymentConfigInput(input *codedeploy.CreateDeploymentConfigInput) error {
+	var failures []error
+
+	if name := aws.ToString(input.DeploymentConfigName); name == "" {
+		failures = append(failures, errors.New("deployment_config_name must not be empty"))
+	} else if err := validateDeploymentConfigNameLength(name); err != nil {
+		failures = append(failures, err)
+	} else if err := validateDeploymentConfigNameNotManaged(name); err != nil {
+		failures = append(failures, err)
+	}
+
+	computePlatform := input.ComputePlatform
+	if computePlatform == "" {
+		computePlatform = types.ComputePlatformServer
+	}
+
+	if !slices.Contains(enum.EnumValues[types.ComputePlatform](), computePlatform) {
+		failures = append(failures, fmt.Errorf("compute_platform must be one of %v, got %q", enum.Values[types.ComputePlatform](), computePlatform))
+	}
+
+	if v := input.MinimumHealthyHosts; v != nil {
+		if computePlatform != types.ComputePlatformServer {
+			failures = append(failures, fmt.Errorf("minimum_healthy_hosts is not supported for the %s compute platform", computePlatform))
+		}
+		if !slices.Contains(enum.EnumValues[types.MinimumHealthyHostsType](), v.Type) {
+			failures = append(failures, fmt.Errorf("minimum_healthy_hosts.type must be one of %v, got %q", enum.Values[types.MinimumHealthyHostsType](), v.Type))
+		}
+		if v.Value < 0 || (v.Type == types.MinimumHealthyHostsTypeFleetPercent && v.Value > 100) {
+			failures = append(failures, fmt.Errorf("minimum_healthy_hosts.value %d is out of range for type %s", v.Value, v.Type))
+		}
+	} else if computePlatform == types.ComputePlatformServer {
+		failures = append(failures, fmt.Errorf("minimum_healthy_hosts is required for the %s compute platform", computePlatform))
+	}
+
+	if v := input.TrafficRoutingConfig; v != nil {
+		if err := validateTrafficRoutingConsistency(v); err != nil {
+			failures = append(failures, err)
+		} else if err := validateTrafficRoutingIntervalForComputePlatform(computePlatform, v); err != nil {
+			// Only reachable if a platform's limits are narrower than CodeDeploy's general bounds.
+			failures = append(failures, err)
+		}
+		if slices.Contains(enum.EnumValues[types.TrafficRoutingType](), v.Type) {
+			if err := validateTrafficRoutingTypeForComputePlatform(computePlatform, v.Type); err != nil {
+				failures = append(failures, err)
+			}
+		}
+	}
+
+	if v := input.ZonalConfig; v != nil {
+		if err := validateZonalConfigForComputePlatform(computePlatform); err != nil {
+			failures = append(failures, err)
+		}
+		if aws.ToInt64(v.FirstZoneMonitorDurationInSeconds) < 0 {
+			failures = append(failures, errors.New("zonal_config.first_zone_monitor_duration_in_seconds must not be negative"))
+		}
+		if aws.ToInt64(v.MonitorDurationInSeconds) < 0 {
+			failures = append(failures, errors.New("zonal_config.monitor_duration_in_seconds must not be negative"))
+		}
+		if v := v.MinimumHealthyHostsPerZone; v != nil {
+			if !slices.Contains(enum.EnumValues[types.MinimumHealthyHostsPerZoneType](), v.Type) {
+				failures = append(failures, fmt.Errorf("zonal_config.minimum_healthy_hosts_per_zone.type must be one of %v, got %q", enum.Values[types.MinimumHealthyHostsPerZoneType](), v.Type))
+			}
+			if v.Value < 0 || (v.Type == types.MinimumHealthyHostsPerZoneTypeFleetPercent && v.Value > 100) {
+				failures = append(failures, fmt.Errorf("zonal_config.minimum_healthy_hosts_per_zone.value %d is out of range for type %s", v.Value, v.Type))
+			}
+		}
+	}
+
+	return errors.Join(failures...)
+}
+
+func deploymentConfigName(d sdkv2.ResourceDiffer) string {
+	return create.NewNameGenerator(
+		create.WithConfiguredName(d.Get("deployment_config_name").(string)),
+		create.WithConfiguredPrefix(d.Get(names.AttrNamePrefix).(string)),
+		create.WithSuffix(d.Get("name_suffix").(string)),
+	).Generate()
+}
+
+func expandCreateDeploymentConfigInput(d sdkv2.ResourceDiffer) *codedeploy.CreateDeploymentConfigInput {
+	return &codedeploy.CreateDeploymentConfigInput{
+		ComputePlatform:      types.ComputePlatform(d.Get("compute_platform").(string)),
+		DeploymentConfigName: aws.String(deploymentConfigName(d)),
+		MinimumHealthyHosts:  expandMinimumHealthyHosts(d),
+		TrafficRoutingConfig: expandTrafficRoutingConfig(d),
+		ZonalConfig:          expandZonalConfig(d),
+	}
+}
+
+func expandMinimumHealthyHosts(d sdkv2.ResourceDiffer) *types.MinimumHealthyHosts {
+	if v, ok := d.GetOk("minimum_healthy_hosts_type"); ok {
+		return &types.MinimumHealthyHosts{
+			Type:  types.MinimumHealthyHostsType(v.(string)),
+			Value: int32(d.Get("minimum_healthy_hosts_value").(int)),
+		}
+	}
+
 	v, ok := d.GetOk("minimum_healthy_hosts")
 	if !ok {
 		return nil
@@ -293,6 +1220,16 @@ func expandMinimumHealthyHosts(d *schema.ResourceData) *types.MinimumHealthyHost
 
 	tfMap := v.([]interface{})[0].(map[string]interface{})
 
+	if v, ok := tfMap["value_string"].(string); ok && v != "" {
+		// Validated at plan time.
+		if typ, value, err := parseMinimumHealthyHostsValueString(v); err == nil {
+			return &types.MinimumHealthyHosts{
+				Type:  typ,
+				Value: value,
+			}
+		}
+	}
+
 	apiObject := &types.MinimumHealthyHosts{
 		Type:  types.MinimumHealthyHostsType(tfMap[names.AttrType].(string)),
 		Value: int32(tfMap[names.AttrValue].(int)),
@@ -301,7 +1238,63 @@ func expandMinimumHealthyHosts(d *schema.ResourceData) *types.MinimumHealthyHost
 	return apiObject
 }
 
-func expandTrafficRoutingConfig(d *schema.ResourceData) *types.TrafficRoutingConfig {
+// parseMinimumHealthyHostsValueString parses a minimum healthy hosts value such as "75%" (FLEET_PERCENT) or "3" (HOST_COUNT).
+func parseMinimumHealthyHostsValueString(s string) (types.MinimumHealthyHostsType, int32, error) {
+	typ := types.MinimumHealthyHostsTypeHostCount
+	v, ok := strings.CutSuffix(s, "%")
+	if ok {
+		typ = types.MinimumHealthyHostsTypeFleetPercent
+	}
+
+	n, err := strconv.ParseInt(v, 10, 32)
+
+	if err != nil || n < 0 {
+		return "", 0, fmt.Errorf("%q is neither a host count (for example \"3\") nor a fleet percentage (for example \"75%%\")", s)
+	}
+
+	if typ == types.MinimumHealthyHostsTypeFleetPercent && n > 100 {
+		return "", 0, fmt.Errorf("%q must not exceed 100%%", s)
+	}
+
+	return typ, int32(n), nil
+}
+
+func formatMinimumHealthyHostsValueString(apiObject *types.MinimumHealthyHosts) string {
+	if apiObject.Type == types.MinimumHealthyHostsTypeFleetPercent {
+		return fmt.Sprintf("%d%%", apiObject.Value)
+	}
+
+	return strconv.Itoa(int(apiObject.Value))
+}
+
+// minimumHealthyHostsDescription renders minimum healthy hosts unambiguously, for example "75%" or "3 hosts".
+func minimumHealthyHostsDescription(apiObject *types.MinimumHealthyHosts) string {
+	if apiObject == nil {
+		return ""
+	}
+
+	switch apiObject.Type {
+	case types.MinimumHealthyHostsTypeFleetPercent:
+		return fmt.Sprintf("%d%%", apiObject.Value)
+	case types.MinimumHealthyHostsTypeHostCount:
+		if apiObject.Value == 1 {
+			return "1 host"
+		}
+		return fmt.Sprintf("%d hosts", apiObject.Value)
+	default:
+		return fmt.Sprintf("%d %s", apiObject.Value, apiObject.Type)
+	}
+}
+
+func validateMinimumHealthyHostsValueString(v interface{}, k string) (ws []string, errors []error) {
+	if _, _, err := parseMinimumHealthyHostsValueString(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %w", k, err))
+	}
+
+	return
+}
+
+func expandTrafficRoutingConfig(d sdkv2.ResourceDiffer) *types.TrafficRoutingConfig {
 	v, ok := d.GetOk("traffic_routing_config")
 	if !ok {
 		return nil
@@ -349,7 +1342,7 @@ func expandTimeBasedLinear(tfMap map[string]interface{}) *types.TimeBasedLinear
 	return apiObject
 }
 
-func expandZonalConfig(d *schema.ResourceData) *types.ZonalConfig {
+func expandZonalConfig(d sdkv2.ResourceDiffer) *types.ZonalConfig {
 	v, ok := d.GetOk("zonal_config")
 	if !ok {
 		return nil
@@ -405,14 +1398,58 @@ func flattenTrafficRoutingConfig(apiObject *types.TrafficRoutingConfig) []interf
 		return tfList
 	}
 
+	// Only surface the sub-block matching the routing type so that, for example, an AllAtOnce
+	// config returned with zero-valued canary or linear settings doesn't produce spurious blocks.
 	tfMap := make(map[string]interface{})
-	tfMap["time_based_canary"] = flattenTimeBasedCanary(apiObject.TimeBasedCanary)
-	tfMap["time_based_linear"] = flattenTimeBasedLinear(apiObject.TimeBasedLinear)
+	tfMap["time_based_canary"] = make([]interface{}, 0)
+	tfMap["time_based_linear"] = make([]interface{}, 0)
 	tfMap[names.AttrType] = apiObject.Type
 
+	switch apiObject.Type {
+	case types.TrafficRoutingTypeTimeBasedCanary:
+		tfMap["time_based_canary"] = flattenTimeBasedCanary(apiObject.TimeBasedCanary)
+	case types.TrafficRoutingTypeTimeBasedLinear:
+		tfMap["time_based_linear"] = flattenTimeBasedLinear(apiObject.TimeBasedLinear)
+	}
+
 	return append(tfList, tfMap)
 }
 
+// trafficRoutingConfigChanged reports whether the settings in two traffic_routing_config values differ.
+// Sub-blocks that don't match the routing type are ignored.
+func trafficRoutingConfigChanged(o, n []interface{}) bool {
+	if len(o) == 0 || o[0] == nil || len(n) == 0 || n[0] == nil {
+		return len(o) != len(n)
+	}
+
+	return deploymentConfigTrafficRoutingHash(o[0]) != deploymentConfigTrafficRoutingHash(n[0])
+}
+
+// deploymentConfigTrafficRoutingHash is a schema.SchemaSetFunc for traffic_routing_config elements.
+// It hashes only the routing type and the interval and percentage of the sub-block matching that type.
+func deploymentConfigTrafficRoutingHash(v interface{}) int {
+	var buf bytes.Buffer
+	tfMap := v.(map[string]interface{})
+	typ := fmt.Sprintf("%v", tfMap[names.AttrType])
+	buf.WriteString(fmt.Sprintf("%s-", typ))
+
+	var key string
+	switch types.TrafficRoutingType(typ) {
+	case types.TrafficRoutingTypeTimeBasedCanary:
+		key = "time_based_canary"
+	case types.TrafficRoutingTypeTimeBasedLinear:
+		key = "time_based_linear"
+	}
+
+	if v, ok := tfMap[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		buf.WriteString(fmt.Sprintf("%v-", tfMap[names.AttrInterval]))
+		buf.WriteString(fmt.Sprintf("%v-", tfMap["percentage"]))
+	}
+
+	return create.StringHashcode(buf.String())
+}
+
 func flattenTimeBasedCanary(apiObject *types.TimeBasedCanary) []interface{} {
 	tfList := make([]interface{}, 0)
 
@@ -444,8 +1481,9 @@ func flattenTimeBasedLinear(apiObject *types.TimeBasedLinear) []interface{} {
 func flattenZonalConfig(apiObject *types.ZonalConfig) []interface{} {
 	tfList := make([]interface{}, 0)
 
-	if apiObject == nil {
-		return nil
+	// Configs without zonal settings, including most AWS-managed ones, may be returned with an empty ZonalConfig.
+	if apiObject == nil || (apiObject.FirstZoneMonitorDurationInSeconds == nil && apiObject.MinimumHealthyHostsPerZone == nil && apiObject.MonitorDurationInSeconds == nil) {
+		return tfList
 	}
 
 	tfMap := make(map[string]interface{})
@@ -460,12 +1498,12 @@ func flattenMinimumHealthHostsPerZone(apiObject *types.MinimumHealthyHostsPerZon
 	tfList := make([]interface{}, 0)
 
 	if apiObject == nil {
-		return nil
+		return tfList
 	}
 
 	tfMap := make(map[string]interface{})
 	tfMap[names.AttrType] = apiObject.Type
-	tfMap[names.AttrValue] = apiObject.Value
+	tfMap[names.AttrValue] = int(apiObject.Value)
 
 	return append(tfList, tfMap)
 }
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deploy
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_codedeploy_deployment_config", name="Deployment Config")
+func dataSourceDeploymentConfig() *schema.Resource {
+	minimumHealthyHostsSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrType: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					names.AttrValue: {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+	timeBasedSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrInterval: {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"percentage": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDeploymentConfigRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_config_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_config_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, deploymentConfigNameMaxLength),
+			},
+			"minimum_healthy_hosts": minimumHealthyHostsSchema(),
+			"traffic_routing_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time_based_canary": timeBasedSchema(),
+						"time_based_linear": timeBasedSchema(),
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"zonal_config": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"first_zone_monitor_duration_in_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"minimum_healthy_hosts_per_zone": minimumHealthyHostsSchema(),
+						"monitor_duration_in_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDeploymentConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeployClient(ctx)
+
+	name := d.Get("deployment_config_name").(string)
+	deploymentConfig, err := findDeploymentConfigByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeDeploy Deployment Config (%s): %s", name, err)
+	}
+
+	deploymentConfigName := aws.ToString(deploymentConfig.DeploymentConfigName)
+	d.SetId(deploymentConfigName)
+	d.Set(names.AttrARN, deploymentConfigARN(ctx, meta.(*conns.AWSClient), deploymentConfigName))
+	d.Set("compute_platform", deploymentConfig.ComputePlatform)
+	d.Set("deployment_config_id", deploymentConfig.DeploymentConfigId)
+	d.Set("deployment_config_name", deploymentConfigName)
+	if err := d.Set("minimum_healthy_hosts", flattenMinimumHealthHosts(deploymentConfig.MinimumHealthyHosts)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting minimum_healthy_hosts: %s", err)
+	}
+	if err := d.Set("traffic_routing_config", flattenTrafficRoutingConfig(deploymentConfig.TrafficRoutingConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting traffic_routing_config: %s", err)
+	}
+	if err := d.Set("zonal_config", flattenZonalConfig(deploymentConfig.ZonalConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting zonal_config: %s", err)
+	}
+
+	return diags
+}
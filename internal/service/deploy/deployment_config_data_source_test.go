@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deploy_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDeployDeploymentConfigDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_codedeploy_deployment_config.test"
+	resourceName := "aws_codedeploy_deployment_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "compute_platform", resourceName, "compute_platform"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployment_config_id", resourceName, "deployment_config_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployment_config_name", resourceName, "deployment_config_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "minimum_healthy_hosts.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "traffic_routing_config.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "traffic_routing_config.0.type", "TimeBasedLinear"),
+					resource.TestCheckResourceAttr(dataSourceName, "traffic_routing_config.0.time_based_canary.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "traffic_routing_config.0.time_based_linear.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "traffic_routing_config.0.time_based_linear.0.interval", "10"),
+					resource.TestCheckResourceAttr(dataSourceName, "traffic_routing_config.0.time_based_linear.0.percentage", "10"),
+					resource.TestCheckResourceAttr(dataSourceName, "zonal_config.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfigDataSource_awsManaged(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_codedeploy_deployment_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigDataSourceConfig_name("CodeDeployDefault.ECSAllAtOnce"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrRegionalARN(ctx, dataSourceName, names.AttrARN, "codedeploy", "deploymentconfig:CodeDeployDefault.ECSAllAtOnce"),
+					resource.TestCheckResourceAttr(dataSourceName, "compute_platform", "ECS"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "deployment_config_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployment_config_name", "CodeDeployDefault.ECSAllAtOnce"),
+					resource.TestCheckResourceAttr(dataSourceName, "minimum_healthy_hosts.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "traffic_routing_config.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "traffic_routing_config.0.type", "AllAtOnce"),
+					resource.TestCheckResourceAttr(dataSourceName, "traffic_routing_config.0.time_based_canary.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "traffic_routing_config.0.time_based_linear.#", "0"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfigDataSourceConfig_name("CodeDeployDefault.OneAtATime"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "compute_platform", "Server"),
+					resource.TestCheckResourceAttr(dataSourceName, "minimum_healthy_hosts.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "minimum_healthy_hosts.0.type", "FLEET_PERCENT"),
+					resource.TestCheckResourceAttr(dataSourceName, "minimum_healthy_hosts.0.value", "99"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentConfigDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = "Lambda"
+
+  traffic_routing_config {
+    type = "TimeBasedLinear"
+
+    time_based_linear {
+      interval   = 10
+      percentage = 10
+    }
+  }
+}
+
+data "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = aws_codedeploy_deployment_config.test.id
+}
+`, rName)
+}
+
+func testAccDeploymentConfigDataSourceConfig_name(name string) string {
+	return fmt.Sprintf(`
+data "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+}
+`, name)
+}
@@ -4,15 +4,28 @@
 package deploy_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
 	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -21,6 +34,2499 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestDeploymentConfigNameFromNameOrARN(t *testing.T) {
+	t.Parallel()
+
+	// lintignore:AWSAT003,AWSAT005
+	testCases := []struct {
+		input         string
+		expected      string
+		errorExpected bool
+	}{
+		{
+			input:    "my-deployment-config",
+			expected: "my-deployment-config",
+		},
+		{
+			input:    "CodeDeployDefault.OneAtATime",
+			expected: "CodeDeployDefault.OneAtATime",
+		},
+		{
+			input:    "arn:aws:codedeploy:us-west-2:123456789012:deploymentconfig:my-deployment-config",
+			expected: "my-deployment-config",
+		},
+		{
+			input:    "arn:aws-us-gov:codedeploy:us-gov-west-1:123456789012:deploymentconfig:my-deployment-config",
+			expected: "my-deployment-config",
+		},
+		{
+			input:    "arn:aws-cn:codedeploy:cn-north-1:123456789012:deploymentconfig:my-deployment-config",
+			expected: "my-deployment-config",
+		},
+		{
+			input:         "arn:aws:codedeploy:us-west-2:123456789012:deploymentgroup:my-app/my-group",
+			errorExpected: true,
+		},
+		{
+			input:         "arn:aws:codedeploy:us-west-2:123456789012:deploymentconfig:",
+			errorExpected: true,
+		},
+		{
+			input:         "arn:aws:lambda:us-west-2:123456789012:deploymentconfig:my-deployment-config",
+			errorExpected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.input, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfcodedeploy.DeploymentConfigNameFromNameOrARN(testCase.input)
+
+			if err != nil && !testCase.errorExpected {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.errorExpected {
+				t.Fatal("expected error, got none")
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestDeploymentConfigARN(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		accountID string
+		region    string
+		expected  string
+	}{
+		"commercial": {
+			accountID: "111122223333",
+			region:    "eu-west-1",                                                       //lintignore:AWSAT003
+			expected:  "arn:aws:codedeploy:eu-west-1:111122223333:deploymentconfig:test", //lintignore:AWSAT003,AWSAT005
+		},
+		"China": {
+			accountID: "444455556666",
+			region:    "cn-north-1",                                                          //lintignore:AWSAT003
+			expected:  "arn:aws-cn:codedeploy:cn-north-1:444455556666:deploymentconfig:test", //lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &conns.AWSClient{}
+			conns.SetAccountID(client, testCase.accountID)
+			conns.SetRegion(client, testCase.region)
+
+			if got := tfcodedeploy.DeploymentConfigARN(ctx, client, "test"); got != testCase.expected {
+				t.Errorf("got %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestValidateCreateDeploymentConfigInput(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         *codedeploy.CreateDeploymentConfigInput
+		errorExpected bool
+	}{
+		"server minimum healthy hosts": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformServer,
+				DeploymentConfigName: aws.String("test"),
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeFleetPercent,
+					Value: 75,
+				},
+			},
+		},
+		"managed name prefix": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformServer,
+				DeploymentConfigName: aws.String("CodeDeployDefault.Custom"),
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeFleetPercent,
+					Value: 75,
+				},
+			},
+			errorExpected: true,
+		},
+		"managed name prefix different case": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformServer,
+				DeploymentConfigName: aws.String("codedeploydefault.Custom"),
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeFleetPercent,
+					Value: 75,
+				},
+			},
+		},
+		"server default compute platform": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				DeploymentConfigName: aws.String("test"),
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeHostCount,
+					Value: 2,
+				},
+			},
+		},
+		"server zonal config": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformServer,
+				DeploymentConfigName: aws.String("test"),
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeHostCount,
+					Value: 3,
+				},
+				ZonalConfig: &types.ZonalConfig{
+					FirstZoneMonitorDurationInSeconds: aws.Int64(10),
+					MinimumHealthyHostsPerZone: &types.MinimumHealthyHostsPerZone{
+						Type:  types.MinimumHealthyHostsPerZoneTypeFleetPercent,
+						Value: 20,
+					},
+					MonitorDurationInSeconds: aws.Int64(10),
+				},
+			},
+		},
+		"lambda canary": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedCanary,
+					TimeBasedCanary: &types.TimeBasedCanary{
+						CanaryInterval:   10,
+						CanaryPercentage: 50,
+					},
+				},
+			},
+		},
+		"ecs linear": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformEcs,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedLinear,
+					TimeBasedLinear: &types.TimeBasedLinear{
+						LinearInterval:   1,
+						LinearPercentage: 10,
+					},
+				},
+			},
+		},
+		"lambda all at once": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeAllAtOnce,
+				},
+			},
+		},
+		"empty name": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform: types.ComputePlatformLambda,
+			},
+			errorExpected: true,
+		},
+		"name too long": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String(strings.Repeat("a", 101)),
+			},
+			errorExpected: true,
+		},
+		"multibyte name at limit": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String(strings.Repeat("é", 100)),
+			},
+		},
+		"invalid compute platform": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatform("Mainframe"),
+				DeploymentConfigName: aws.String("test"),
+			},
+			errorExpected: true,
+		},
+		"server without minimum healthy hosts": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformServer,
+				DeploymentConfigName: aws.String("test"),
+			},
+			errorExpected: true,
+		},
+		"fleet percent over 100": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformServer,
+				DeploymentConfigName: aws.String("test"),
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeFleetPercent,
+					Value: 101,
+				},
+			},
+			errorExpected: true,
+		},
+		"lambda minimum healthy hosts": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeHostCount,
+					Value: 1,
+				},
+			},
+			errorExpected: true,
+		},
+		"server traffic routing": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformServer,
+				DeploymentConfigName: aws.String("test"),
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeHostCount,
+					Value: 1,
+				},
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeAllAtOnce,
+				},
+			},
+			errorExpected: true,
+		},
+		"canary type without block": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedCanary,
+				},
+			},
+			errorExpected: true,
+		},
+		"linear block with canary type": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedCanary,
+					TimeBasedLinear: &types.TimeBasedLinear{
+						LinearInterval:   1,
+						LinearPercentage: 10,
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		"canary percentage over 100": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedCanary,
+					TimeBasedCanary: &types.TimeBasedCanary{
+						CanaryInterval:   10,
+						CanaryPercentage: 101,
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		"canary percentage 100": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedCanary,
+					TimeBasedCanary: &types.TimeBasedCanary{
+						CanaryInterval:   10,
+						CanaryPercentage: 100,
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		"canary maximum interval": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedCanary,
+					TimeBasedCanary: &types.TimeBasedCanary{
+						CanaryInterval:   2880,
+						CanaryPercentage: 10,
+					},
+				},
+			},
+		},
+		"canary interval over maximum": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformEcs,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedCanary,
+					TimeBasedCanary: &types.TimeBasedCanary{
+						CanaryInterval:   2881,
+						CanaryPercentage: 10,
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		"linear percentage 100": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformEcs,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedLinear,
+					TimeBasedLinear: &types.TimeBasedLinear{
+						LinearInterval:   10,
+						LinearPercentage: 100,
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		"linear interval over maximum": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedLinear,
+					TimeBasedLinear: &types.TimeBasedLinear{
+						LinearInterval:   2881,
+						LinearPercentage: 50,
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		"linear maximum duration": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedLinear,
+					TimeBasedLinear: &types.TimeBasedLinear{
+						LinearInterval:   320,
+						LinearPercentage: 10,
+					},
+				},
+			},
+		},
+		"linear duration over maximum": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedLinear,
+					TimeBasedLinear: &types.TimeBasedLinear{
+						LinearInterval:   321,
+						LinearPercentage: 10,
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		"linear uneven steps over maximum": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformEcs,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedLinear,
+					TimeBasedLinear: &types.TimeBasedLinear{
+						LinearInterval:   961,
+						LinearPercentage: 30,
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		"linear uneven steps at maximum": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformEcs,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedLinear,
+					TimeBasedLinear: &types.TimeBasedLinear{
+						LinearInterval:   960,
+						LinearPercentage: 30,
+					},
+				},
+			},
+		},
+		"linear zero interval": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformEcs,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeTimeBasedLinear,
+					TimeBasedLinear: &types.TimeBasedLinear{
+						LinearPercentage: 10,
+					},
+				},
+			},
+			errorExpected: true,
+		},
+		"ecs zonal config": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformEcs,
+				DeploymentConfigName: aws.String("test"),
+				ZonalConfig: &types.ZonalConfig{
+					MonitorDurationInSeconds: aws.Int64(10),
+				},
+			},
+			errorExpected: true,
+		},
+		"zonal config negative duration": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformServer,
+				DeploymentConfigName: aws.String("test"),
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeHostCount,
+					Value: 1,
+				},
+				ZonalConfig: &types.ZonalConfig{
+					MonitorDurationInSeconds: aws.Int64(-1),
+				},
+			},
+			errorExpected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfcodedeploy.ValidateCreateDeploymentConfigInput(testCase.input)
+
+			if err != nil && !testCase.errorExpected {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.errorExpected {
+				t.Fatal("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestValidateTrafficRoutingConsistency(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         *types.TrafficRoutingConfig
+		errorExpected bool
+	}{
+		"nil": {},
+		"all at once": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeAllAtOnce,
+			},
+		},
+		"canary": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   10,
+					CanaryPercentage: 10,
+				},
+			},
+		},
+		"linear": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   1,
+					LinearPercentage: 10,
+				},
+			},
+		},
+		"invalid type": {
+			input: &types.TrafficRoutingConfig{
+				Type: "Blue",
+			},
+			errorExpected: true,
+		},
+		"canary missing sub-block": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+			},
+			errorExpected: true,
+		},
+		"linear missing sub-block": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+			},
+			errorExpected: true,
+		},
+		"canary with linear sub-block": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   1,
+					LinearPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"canary and linear sub-blocks": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   10,
+					CanaryPercentage: 10,
+				},
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   1,
+					LinearPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"all at once with canary sub-block": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeAllAtOnce,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   10,
+					CanaryPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"canary interval zero": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"canary interval maximum": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   2880,
+					CanaryPercentage: 10,
+				},
+			},
+		},
+		"canary interval over maximum": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   2881,
+					CanaryPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"canary percentage 100": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   10,
+					CanaryPercentage: 100,
+				},
+			},
+			errorExpected: true,
+		},
+		"linear percentage zero": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval: 1,
+				},
+			},
+			errorExpected: true,
+		},
+		"linear total duration maximum": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   320,
+					LinearPercentage: 10,
+				},
+			},
+		},
+		"linear total duration over maximum": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   321,
+					LinearPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfcodedeploy.ValidateTrafficRoutingConsistency(testCase.input)
+
+			if err != nil && !testCase.errorExpected {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.errorExpected {
+				t.Fatal("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestValidateTrafficRoutingIntervalForComputePlatform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		computePlatform types.ComputePlatform
+		input           *types.TrafficRoutingConfig
+		errorExpected   bool
+	}{
+		"ECS canary interval below minimum": {
+			computePlatform: types.ComputePlatformEcs,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   0,
+					CanaryPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"ECS canary interval minimum": {
+			computePlatform: types.ComputePlatformEcs,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   1,
+					CanaryPercentage: 10,
+				},
+			},
+		},
+		"ECS canary interval maximum": {
+			computePlatform: types.ComputePlatformEcs,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   2880,
+					CanaryPercentage: 10,
+				},
+			},
+		},
+		"ECS canary interval above maximum": {
+			computePlatform: types.ComputePlatformEcs,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   2881,
+					CanaryPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"ECS linear interval below minimum": {
+			computePlatform: types.ComputePlatformEcs,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   0,
+					LinearPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"ECS linear interval minimum": {
+			computePlatform: types.ComputePlatformEcs,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   1,
+					LinearPercentage: 10,
+				},
+			},
+		},
+		"ECS linear interval maximum": {
+			computePlatform: types.ComputePlatformEcs,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   2880,
+					LinearPercentage: 10,
+				},
+			},
+		},
+		"ECS linear interval above maximum": {
+			computePlatform: types.ComputePlatformEcs,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   2881,
+					LinearPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"Lambda canary interval below minimum": {
+			computePlatform: types.ComputePlatformLambda,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   0,
+					CanaryPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"Lambda canary interval minimum": {
+			computePlatform: types.ComputePlatformLambda,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   1,
+					CanaryPercentage: 10,
+				},
+			},
+		},
+		"Lambda canary interval maximum": {
+			computePlatform: types.ComputePlatformLambda,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   2880,
+					CanaryPercentage: 10,
+				},
+			},
+		},
+		"Lambda canary interval above maximum": {
+			computePlatform: types.ComputePlatformLambda,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   2881,
+					CanaryPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"Lambda linear interval below minimum": {
+			computePlatform: types.ComputePlatformLambda,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   0,
+					LinearPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"Lambda linear interval minimum": {
+			computePlatform: types.ComputePlatformLambda,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   1,
+					LinearPercentage: 10,
+				},
+			},
+		},
+		"Lambda linear interval maximum": {
+			computePlatform: types.ComputePlatformLambda,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   2880,
+					LinearPercentage: 10,
+				},
+			},
+		},
+		"Lambda linear interval above maximum": {
+			computePlatform: types.ComputePlatformLambda,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   2881,
+					LinearPercentage: 10,
+				},
+			},
+			errorExpected: true,
+		},
+		"Server ignored": {
+			computePlatform: types.ComputePlatformServer,
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryPercentage: 10,
+				},
+			},
+		},
+		"nil": {
+			computePlatform: types.ComputePlatformLambda,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfcodedeploy.ValidateTrafficRoutingIntervalForComputePlatform(testCase.computePlatform, testCase.input)
+
+			if err != nil && !testCase.errorExpected {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.errorExpected {
+				t.Fatal("expected error, got none")
+			}
+
+			if err != nil && !strings.Contains(err.Error(), string(testCase.computePlatform)) {
+				t.Errorf("error %q does not name the %s compute platform", err, testCase.computePlatform)
+			}
+		})
+	}
+}
+
+func TestValidateTrafficRoutingTypeForComputePlatform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		computePlatform types.ComputePlatform
+		typ             types.TrafficRoutingType
+		errorExpected   bool
+	}{
+		"ECS AllAtOnce": {
+			computePlatform: types.ComputePlatformEcs,
+			typ:             types.TrafficRoutingTypeAllAtOnce,
+		},
+		"ECS TimeBasedCanary": {
+			computePlatform: types.ComputePlatformEcs,
+			typ:             types.TrafficRoutingTypeTimeBasedCanary,
+		},
+		"ECS TimeBasedLinear": {
+			computePlatform: types.ComputePlatformEcs,
+			typ:             types.TrafficRoutingTypeTimeBasedLinear,
+		},
+		"Lambda AllAtOnce": {
+			computePlatform: types.ComputePlatformLambda,
+			typ:             types.TrafficRoutingTypeAllAtOnce,
+		},
+		"Lambda TimeBasedCanary": {
+			computePlatform: types.ComputePlatformLambda,
+			typ:             types.TrafficRoutingTypeTimeBasedCanary,
+		},
+		"Lambda TimeBasedLinear": {
+			computePlatform: types.ComputePlatformLambda,
+			typ:             types.TrafficRoutingTypeTimeBasedLinear,
+		},
+		"Server AllAtOnce": {
+			computePlatform: types.ComputePlatformServer,
+			typ:             types.TrafficRoutingTypeAllAtOnce,
+			errorExpected:   true,
+		},
+		"Server TimeBasedCanary": {
+			computePlatform: types.ComputePlatformServer,
+			typ:             types.TrafficRoutingTypeTimeBasedCanary,
+			errorExpected:   true,
+		},
+		"Server TimeBasedLinear": {
+			computePlatform: types.ComputePlatformServer,
+			typ:             types.TrafficRoutingTypeTimeBasedLinear,
+			errorExpected:   true,
+		},
+		"unrecognized platform": {
+			computePlatform: "Unknown",
+			typ:             types.TrafficRoutingTypeTimeBasedLinear,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfcodedeploy.ValidateTrafficRoutingTypeForComputePlatform(testCase.computePlatform, testCase.typ)
+
+			if err != nil && !testCase.errorExpected {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.errorExpected {
+				t.Fatal("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestValidateTrafficRoutingTypeConfigured(t *testing.T) {
+	t.Parallel()
+
+	intervalType := cty.Object(map[string]cty.Type{
+		names.AttrInterval: cty.Number,
+		"percentage":       cty.Number,
+	})
+	trafficRoutingConfig := func(typ cty.Value, canary, linear cty.Value) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			names.AttrType:      typ,
+			"time_based_canary": canary,
+			"time_based_linear": linear,
+		})})
+	}
+	intervalBlock := cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+		names.AttrInterval: cty.NumberIntVal(10),
+		"percentage":       cty.NumberIntVal(20),
+	})})
+	noBlock := cty.ListValEmpty(intervalType)
+
+	testCases := map[string]struct {
+		config        cty.Value
+		expectedError *regexp.Regexp
+	}{
+		"null": {
+			config: cty.NullVal(cty.List(cty.DynamicPseudoType)),
+		},
+		"type without block": {
+			config: trafficRoutingConfig(cty.StringVal("AllAtOnce"), noBlock, noBlock),
+		},
+		"no type or block": {
+			config: trafficRoutingConfig(cty.NullVal(cty.String), noBlock, noBlock),
+		},
+		"canary with type": {
+			config: trafficRoutingConfig(cty.StringVal("TimeBasedCanary"), intervalBlock, noBlock),
+		},
+		"canary without type": {
+			config:        trafficRoutingConfig(cty.NullVal(cty.String), intervalBlock, noBlock),
+			expectedError: regexache.MustCompile(`traffic_routing_config.type is required when traffic_routing_config.time_based_canary is set`),
+		},
+		"linear without type": {
+			config:        trafficRoutingConfig(cty.NullVal(cty.String), noBlock, intervalBlock),
+			expectedError: regexache.MustCompile(`traffic_routing_config.type is required when traffic_routing_config.time_based_linear is set`),
+		},
+		"unknown type": {
+			config: trafficRoutingConfig(cty.UnknownVal(cty.String), intervalBlock, noBlock),
+		},
+		"canary type without block": {
+			config:        trafficRoutingConfig(cty.StringVal("TimeBasedCanary"), noBlock, noBlock),
+			expectedError: regexache.MustCompile(`traffic_routing_config.time_based_canary is required when type is TimeBasedCanary`),
+		},
+		"linear type without block": {
+			config:        trafficRoutingConfig(cty.StringVal("TimeBasedLinear"), noBlock, noBlock),
+			expectedError: regexache.MustCompile(`traffic_routing_config.time_based_linear is required when type is TimeBasedLinear`),
+		},
+		"linear type with unknown percentage": {
+			config: trafficRoutingConfig(cty.StringVal("TimeBasedLinear"), noBlock, cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				names.AttrInterval: cty.NumberIntVal(10),
+				"percentage":       cty.UnknownVal(cty.Number),
+			})})),
+		},
+		"canary type with unknown block": {
+			config: trafficRoutingConfig(cty.StringVal("TimeBasedCanary"), cty.UnknownVal(cty.List(intervalType)), noBlock),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfcodedeploy.ValidateTrafficRoutingTypeConfigured(testCase.config)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("error %q does not match %q", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestValidateZonalConfigForComputePlatform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		computePlatform types.ComputePlatform
+		expectedError   *regexp.Regexp
+	}{
+		"Server": {
+			computePlatform: types.ComputePlatformServer,
+		},
+		"ECS": {
+			computePlatform: types.ComputePlatformEcs,
+			expectedError:   regexache.MustCompile(`zonal_config is not supported for the ECS compute platform`),
+		},
+		"Lambda": {
+			computePlatform: types.ComputePlatformLambda,
+			expectedError:   regexache.MustCompile(`zonal_config is not supported for the Lambda compute platform`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfcodedeploy.ValidateZonalConfigForComputePlatform(testCase.computePlatform)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("error %q does not match %q", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestZonalConfigMissingMinimumHealthyHostsPerZone(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject *types.ZonalConfig
+		expected  bool
+	}{
+		"nil": {},
+		"empty": {
+			apiObject: &types.ZonalConfig{
+				FirstZoneMonitorDurationInSeconds: aws.Int64(0),
+				MonitorDurationInSeconds:          aws.Int64(0),
+			},
+		},
+		"durations without per-zone hosts": {
+			apiObject: &types.ZonalConfig{
+				FirstZoneMonitorDurationInSeconds: aws.Int64(0),
+				MonitorDurationInSeconds:          aws.Int64(60),
+			},
+			expected: true,
+		},
+		"first zone duration without per-zone hosts": {
+			apiObject: &types.ZonalConfig{
+				FirstZoneMonitorDurationInSeconds: aws.Int64(60),
+			},
+			expected: true,
+		},
+		"durations with per-zone hosts": {
+			apiObject: &types.ZonalConfig{
+				FirstZoneMonitorDurationInSeconds: aws.Int64(60),
+				MinimumHealthyHostsPerZone: &types.MinimumHealthyHostsPerZone{
+					Type:  types.MinimumHealthyHostsPerZoneTypeHostCount,
+					Value: 1,
+				},
+				MonitorDurationInSeconds: aws.Int64(60),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfcodedeploy.ZonalConfigMissingMinimumHealthyHostsPerZone(testCase.apiObject); got != testCase.expected {
+				t.Errorf("got %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestTimeBasedLinearPartialLastStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input            *types.TrafficRoutingConfig
+		expectedLastStep int32
+		expected         bool
+	}{
+		"nil": {},
+		"all at once": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeAllAtOnce,
+			},
+		},
+		"canary partial": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedCanary,
+				TimeBasedCanary: &types.TimeBasedCanary{
+					CanaryInterval:   10,
+					CanaryPercentage: 30,
+				},
+			},
+		},
+		"linear 10": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   10,
+					LinearPercentage: 10,
+				},
+			},
+		},
+		"linear 30": {
+			input: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   10,
+					LinearPercentage: 30,
+				},
+			},
+			expectedLastStep: 10,
+			expected:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := tfcodedeploy.TimeBasedLinearPartialLastStep(testCase.input)
+
+			if ok != testCase.expected {
+				t.Errorf("got %t, want %t", ok, testCase.expected)
+			}
+
+			if got != testCase.expectedLastStep {
+				t.Errorf("last step = %d, want %d", got, testCase.expectedLastStep)
+			}
+
+			// A partial last step is valid; it only warrants a warning.
+			if err := tfcodedeploy.ValidateCreateDeploymentConfigInput(&codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("test"),
+				TrafficRoutingConfig: testCase.input,
+			}); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestMinimumHealthyHostsWithPerZone(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    *codedeploy.CreateDeploymentConfigInput
+		expected bool
+	}{
+		"minimum healthy hosts only": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeFleetPercent,
+					Value: 75,
+				},
+			},
+		},
+		"zonal config without per-zone minimum": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeFleetPercent,
+					Value: 75,
+				},
+				ZonalConfig: &types.ZonalConfig{
+					MonitorDurationInSeconds: aws.Int64(60),
+				},
+			},
+		},
+		"both set": {
+			input: &codedeploy.CreateDeploymentConfigInput{
+				MinimumHealthyHosts: &types.MinimumHealthyHosts{
+					Type:  types.MinimumHealthyHostsTypeFleetPercent,
+					Value: 75,
+				},
+				ZonalConfig: &types.ZonalConfig{
+					MinimumHealthyHostsPerZone: &types.MinimumHealthyHostsPerZone{
+						Type:  types.MinimumHealthyHostsPerZoneTypeHostCount,
+						Value: 1,
+					},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfcodedeploy.MinimumHealthyHostsWithPerZone(testCase.input); got != testCase.expected {
+				t.Errorf("got %t, want %t", got, testCase.expected)
+			}
+
+			// The combination is valid; it only warrants a warning.
+			if err := tfcodedeploy.ValidateCreateDeploymentConfigInput(&codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformServer,
+				DeploymentConfigName: aws.String("test"),
+				MinimumHealthyHosts:  testCase.input.MinimumHealthyHosts,
+				ZonalConfig:          testCase.input.ZonalConfig,
+			}); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateDeploymentConfigNameLength(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		errorExpected bool
+	}{
+		"short": {
+			name: "test",
+		},
+		"ASCII at limit": {
+			name: strings.Repeat("a", 100),
+		},
+		"ASCII over limit": {
+			name:          strings.Repeat("a", 101),
+			errorExpected: true,
+		},
+		"multibyte at limit": {
+			name: strings.Repeat("é", 100),
+		},
+		"multibyte over limit": {
+			name:          strings.Repeat("é", 101),
+			errorExpected: true,
+		},
+		"4-byte runes at limit": {
+			name: strings.Repeat("😀", 100),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfcodedeploy.ValidateDeploymentConfigNameLength(testCase.name)
+
+			if err != nil && !testCase.errorExpected {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.errorExpected {
+				t.Fatal("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestDefaultMinimumHealthyHosts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		computePlatform types.ComputePlatform
+		expected        *types.MinimumHealthyHosts
+	}{
+		"Server": {
+			computePlatform: types.ComputePlatformServer,
+			expected: &types.MinimumHealthyHosts{
+				Type:  types.MinimumHealthyHostsTypeFleetPercent,
+				Value: 99,
+			},
+		},
+		"Lambda": {
+			computePlatform: types.ComputePlatformLambda,
+		},
+		"ECS": {
+			computePlatform: types.ComputePlatformEcs,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcodedeploy.DefaultMinimumHealthyHosts(testCase.computePlatform)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(types.MinimumHealthyHosts{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeploymentConfigName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		name          string
+		namePrefix    string
+		nameSuffix    string
+		expected      *regexp.Regexp
+		errorExpected bool
+	}{
+		"name": {
+			name:     "test",
+			expected: regexache.MustCompile(`^test$`),
+		},
+		"prefix": {
+			namePrefix: "team-",
+			expected:   regexache.MustCompile(`^team-[[:xdigit:]]{26}$`),
+		},
+		"suffix": {
+			nameSuffix: "-canary",
+			expected:   regexache.MustCompile(`^terraform-[[:xdigit:]]{26}-canary$`),
+		},
+		"prefix and suffix": {
+			namePrefix: "team-",
+			nameSuffix: "-canary",
+			expected:   regexache.MustCompile(`^team-[[:xdigit:]]{26}-canary$`),
+		},
+		"prefix and suffix at maximum length": {
+			namePrefix: strings.Repeat("p", 37),
+			nameSuffix: strings.Repeat("s", 37),
+			expected:   regexache.MustCompile(`^p{37}[[:xdigit:]]{26}s{37}$`),
+		},
+		"prefix and suffix too long": {
+			namePrefix:    strings.Repeat("p", 37),
+			nameSuffix:    strings.Repeat("s", 38),
+			expected:      regexache.MustCompile(`^p{37}[[:xdigit:]]{26}s{38}$`),
+			errorExpected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := tfcodedeploy.ResourceDeploymentConfig().Data(nil)
+			d.Set("deployment_config_name", testCase.name)
+			d.Set(names.AttrNamePrefix, testCase.namePrefix)
+			d.Set("name_suffix", testCase.nameSuffix)
+
+			got := tfcodedeploy.DeploymentConfigName(d)
+
+			if !testCase.expected.MatchString(got) {
+				t.Errorf("got %q, expected to match %s", got, testCase.expected)
+			}
+
+			err := tfcodedeploy.ValidateCreateDeploymentConfigInput(&codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String(got),
+			})
+
+			if err != nil && !testCase.errorExpected {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.errorExpected {
+				t.Fatal("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestExpandMinimumHealthyHosts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		block    []interface{}
+		typ      string
+		value    int
+		expected *types.MinimumHealthyHosts
+	}{
+		"none": {},
+		"block": {
+			block: []interface{}{
+				map[string]interface{}{
+					names.AttrType:  string(types.MinimumHealthyHostsTypeFleetPercent),
+					names.AttrValue: 75,
+				},
+			},
+			expected: &types.MinimumHealthyHosts{
+				Type:  types.MinimumHealthyHostsTypeFleetPercent,
+				Value: 75,
+			},
+		},
+		"shorthand": {
+			typ:   string(types.MinimumHealthyHostsTypeHostCount),
+			value: 2,
+			expected: &types.MinimumHealthyHosts{
+				Type:  types.MinimumHealthyHostsTypeHostCount,
+				Value: 2,
+			},
+		},
+		"block value string percent": {
+			block: []interface{}{
+				map[string]interface{}{
+					"value_string": "75%",
+				},
+			},
+			expected: &types.MinimumHealthyHosts{
+				Type:  types.MinimumHealthyHostsTypeFleetPercent,
+				Value: 75,
+			},
+		},
+		"block value string count": {
+			block: []interface{}{
+				map[string]interface{}{
+					"value_string": "3",
+				},
+			},
+			expected: &types.MinimumHealthyHosts{
+				Type:  types.MinimumHealthyHostsTypeHostCount,
+				Value: 3,
+			},
+		},
+		"shorthand zero value": {
+			typ:   string(types.MinimumHealthyHostsTypeHostCount),
+			value: 0,
+			expected: &types.MinimumHealthyHosts{
+				Type:  types.MinimumHealthyHostsTypeHostCount,
+				Value: 0,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := tfcodedeploy.ResourceDeploymentConfig().Data(nil)
+			d.Set("minimum_healthy_hosts", testCase.block)
+			d.Set("minimum_healthy_hosts_type", testCase.typ)
+			d.Set("minimum_healthy_hosts_value", testCase.value)
+
+			got := tfcodedeploy.ExpandMinimumHealthyHosts(d)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.IgnoreUnexported(types.MinimumHealthyHosts{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFindDeploymentGroupIDsByDeploymentConfigName(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		maxGroups int
+		expected  []string
+	}{
+		"unbounded": {
+			maxGroups: 1000,
+			expected:  []string{"app-a:group-2", "app-b:group-1"},
+		},
+		"bounded": {
+			maxGroups: 2,
+			expected:  []string{"app-a:group-2"},
+		},
+		"all": {
+			maxGroups: 0,
+			expected:  []string{"app-a:group-2", "app-b:group-1"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := testDeploymentConfigUsedByStubClient(map[string]map[string]string{
+				"app-a": {"group-1": "CodeDeployDefault.AllAtOnce", "group-2": "custom"},
+				"app-b": {"group-1": "custom"},
+			}, nil, "")
+
+			got, err := tfcodedeploy.FindDeploymentGroupIDsByDeploymentConfigName(ctx, conn, "custom", testCase.maxGroups)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReplaceDeploymentConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		applications  map[string]map[string]string
+		fail          string
+		expected      []string
+		expectedError *regexp.Regexp
+	}{
+		"not in use": {
+			applications: map[string]map[string]string{
+				"app-a": {"group-1": "CodeDeployDefault.AllAtOnce"},
+			},
+			expected: []string{
+				"CreateDeploymentConfig <replacement>",
+				"DeleteDeploymentConfig custom",
+				"CreateDeploymentConfig custom",
+				"DeleteDeploymentConfig <replacement>",
+			},
+		},
+		"in use": {
+			applications: map[string]map[string]string{
+				"app-a": {"group-1": "CodeDeployDefault.AllAtOnce", "group-2": "custom"},
+				"app-b": {"group-1": "custom"},
+			},
+			expected: []string{
+				"CreateDeploymentConfig <replacement>",
+				"UpdateDeploymentGroup app-a:group-2 <replacement>",
+				"UpdateDeploymentGroup app-b:group-1 <replacement>",
+				"DeleteDeploymentConfig custom",
+				"CreateDeploymentConfig custom",
+				"UpdateDeploymentGroup app-a:group-2 custom",
+				"UpdateDeploymentGroup app-b:group-1 custom",
+				"DeleteDeploymentConfig <replacement>",
+			},
+		},
+		"switch to copy fails": {
+			applications: map[string]map[string]string{
+				"app-a": {"group-1": "CodeDeployDefault.AllAtOnce", "group-2": "custom"},
+				"app-b": {"group-1": "custom"},
+			},
+			fail: "UpdateDeploymentGroup app-b:group-1 <replacement>",
+			expected: []string{
+				"CreateDeploymentConfig <replacement>",
+				"UpdateDeploymentGroup app-a:group-2 <replacement>",
+				"UpdateDeploymentGroup app-b:group-1 <replacement>",
+				"UpdateDeploymentGroup app-a:group-2 custom",
+				"DeleteDeploymentConfig <replacement>",
+			},
+			expectedError: regexache.MustCompile(`updating CodeDeploy Deployment Group \(app-b:group-1\)`),
+		},
+		"delete fails": {
+			applications: map[string]map[string]string{
+				"app-a": {"group-2": "custom"},
+				"app-b": {"group-1": "custom"},
+			},
+			fail: "DeleteDeploymentConfig custom",
+			expected: []string{
+				"CreateDeploymentConfig <replacement>",
+				"UpdateDeploymentGroup app-a:group-2 <replacement>",
+				"UpdateDeploymentGroup app-b:group-1 <replacement>",
+				"DeleteDeploymentConfig custom",
+				"UpdateDeploymentGroup app-a:group-2 custom",
+				"UpdateDeploymentGroup app-b:group-1 custom",
+				"DeleteDeploymentConfig <replacement>",
+			},
+			expectedError: regexache.MustCompile(`deleting CodeDeploy Deployment Config \(custom\)`),
+		},
+		"recreate fails": {
+			applications: map[string]map[string]string{
+				"app-a": {"group-2": "custom"},
+				"app-b": {"group-1": "custom"},
+			},
+			fail: "CreateDeploymentConfig custom",
+			expected: []string{
+				"CreateDeploymentConfig <replacement>",
+				"UpdateDeploymentGroup app-a:group-2 <replacement>",
+				"UpdateDeploymentGroup app-b:group-1 <replacement>",
+				"DeleteDeploymentConfig custom",
+				"CreateDeploymentConfig custom",
+				// The config is restored with its old settings.
+				"CreateDeploymentConfig custom",
+				"UpdateDeploymentGroup app-a:group-2 custom",
+				"UpdateDeploymentGroup app-b:group-1 custom",
+				"DeleteDeploymentConfig <replacement>",
+			},
+			expectedError: regexache.MustCompile(`creating CodeDeploy Deployment Config \(custom\)`),
+		},
+		"switch back fails": {
+			applications: map[string]map[string]string{
+				"app-a": {"group-2": "custom"},
+				"app-b": {"group-1": "custom"},
+			},
+			fail: "UpdateDeploymentGroup app-b:group-1 custom",
+			expected: []string{
+				"CreateDeploymentConfig <replacement>",
+				"UpdateDeploymentGroup app-a:group-2 <replacement>",
+				"UpdateDeploymentGroup app-b:group-1 <replacement>",
+				"DeleteDeploymentConfig custom",
+				"CreateDeploymentConfig custom",
+				"UpdateDeploymentGroup app-a:group-2 custom",
+				"UpdateDeploymentGroup app-b:group-1 custom",
+				"UpdateDeploymentGroup app-b:group-1 custom",
+				"DeleteDeploymentConfig <replacement>",
+			},
+			expectedError: regexache.MustCompile(`updating CodeDeploy Deployment Group \(app-b:group-1\)`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+			conn := testDeploymentConfigUsedByStubClient(testCase.applications, &calls, testCase.fail)
+
+			err := tfcodedeploy.ReplaceDeploymentConfig(ctx, conn, &codedeploy.CreateDeploymentConfigInput{
+				ComputePlatform:      types.ComputePlatformLambda,
+				DeploymentConfigName: aws.String("custom"),
+				TrafficRoutingConfig: &types.TrafficRoutingConfig{
+					Type: types.TrafficRoutingTypeAllAtOnce,
+				},
+			})
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if err == nil || !testCase.expectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got %v", testCase.expectedError, err)
+			}
+
+			if diff := cmp.Diff(calls, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			for applicationName, deploymentGroups := range testCase.applications {
+				for deploymentGroupName, deploymentConfigName := range deploymentGroups {
+					if deploymentConfigName != "custom" && !strings.HasPrefix(deploymentConfigName, "CodeDeployDefault.") {
+						t.Errorf("deployment group %s:%s uses %s", applicationName, deploymentGroupName, deploymentConfigName)
+					}
+				}
+			}
+		})
+	}
+}
+
+// testDeploymentConfigUsedByStubClient returns a client whose application and deployment group calls are answered
+// locally from the supplied application name -> deployment group name -> deployment config name map.
+// Deployment config changes and deployment group updates are appended to calls, if set; updates are applied to the map.
+// Generated replacement config names are recorded as <replacement>. The first call recorded as fail returns an error.
+// Every deployment group has an alarm and a trigger, and updates that would clear them are rejected.
+func testDeploymentConfigUsedByStubClient(applications map[string]map[string]string, calls *[]string, fail string) *codedeploy.Client {
+	replacement := regexache.MustCompile(`\bterraform-replacing-[0-9a-f]+\b`)
+	record := func(call string) error {
+		call = replacement.ReplaceAllString(call, "<replacement>")
+		*calls = append(*calls, call)
+
+		if fail != "" && call == fail {
+			fail = ""
+			return errors.New("simulated failure")
+		}
+
+		return nil
+	}
+
+	return codedeploy.New(codedeploy.Options{
+		Region: "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{
+			func(stack *middleware.Stack) error {
+				return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("stubDeploymentGroups",
+					func(_ context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+						switch params := in.Parameters.(type) {
+						case *codedeploy.ListApplicationsInput:
+							output := &codedeploy.ListApplicationsOutput{}
+							for name := range applications {
+								output.Applications = append(output.Applications, name)
+							}
+							slices.Sort(output.Applications)
+
+							return middleware.InitializeOutput{Result: output}, middleware.Metadata{}, nil
+						case *codedeploy.ListDeploymentGroupsInput:
+							output := &codedeploy.ListDeploymentGroupsOutput{}
+							for name := range applications[aws.ToString(params.ApplicationName)] {
+								output.DeploymentGroups = append(output.DeploymentGroups, name)
+							}
+							slices.Sort(output.DeploymentGroups)
+
+							return middleware.InitializeOutput{Result: output}, middleware.Metadata{}, nil
+						case *codedeploy.BatchGetDeploymentGroupsInput:
+							output := &codedeploy.BatchGetDeploymentGroupsOutput{}
+							for _, name := range params.DeploymentGroupNames {
+								output.DeploymentGroupsInfo = append(output.DeploymentGroupsInfo, types.DeploymentGroupInfo{
+									AlarmConfiguration: &types.AlarmConfiguration{
+										Alarms:  []types.Alarm{{Name: aws.String("alarm")}},
+										Enabled: true,
+									},
+									ApplicationName:       params.ApplicationName,
+									DeploymentConfigName:  aws.String(applications[aws.ToString(params.ApplicationName)][name]),
+									DeploymentGroupName:   aws.String(name),
+									TriggerConfigurations: []types.TriggerConfig{{TriggerName: aws.String("trigger")}},
+								})
+							}
+
+							return middleware.InitializeOutput{Result: output}, middleware.Metadata{}, nil
+						case *codedeploy.GetDeploymentConfigInput:
+							output := &codedeploy.GetDeploymentConfigOutput{
+								DeploymentConfigInfo: &types.DeploymentConfigInfo{
+									ComputePlatform:      types.ComputePlatformLambda,
+									DeploymentConfigName: params.DeploymentConfigName,
+									TrafficRoutingConfig: &types.TrafficRoutingConfig{
+										Type: types.TrafficRoutingTypeAllAtOnce,
+									},
+								},
+							}
+
+							return middleware.InitializeOutput{Result: output}, middleware.Metadata{}, nil
+						case *codedeploy.CreateDeploymentConfigInput:
+							if err := record("CreateDeploymentConfig " + aws.ToString(params.DeploymentConfigName)); err != nil {
+								return middleware.InitializeOutput{}, middleware.Metadata{}, err
+							}
+
+							return middleware.InitializeOutput{Result: &codedeploy.CreateDeploymentConfigOutput{}}, middleware.Metadata{}, nil
+						case *codedeploy.DeleteDeploymentConfigInput:
+							if err := record("DeleteDeploymentConfig " + aws.ToString(params.DeploymentConfigName)); err != nil {
+								return middleware.InitializeOutput{}, middleware.Metadata{}, err
+							}
+
+							return middleware.InitializeOutput{Result: &codedeploy.DeleteDeploymentConfigOutput{}}, middleware.Metadata{}, nil
+						case *codedeploy.UpdateDeploymentGroupInput:
+							applicationName, deploymentGroupName := aws.ToString(params.ApplicationName), aws.ToString(params.CurrentDeploymentGroupName)
+							if err := record(fmt.Sprintf("UpdateDeploymentGroup %s:%s %s", applicationName, deploymentGroupName, aws.ToString(params.DeploymentConfigName))); err != nil {
+								return middleware.InitializeOutput{}, middleware.Metadata{}, err
+							}
+
+							if params.AlarmConfiguration == nil || len(params.TriggerConfigurations) == 0 {
+								return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("updating %s:%s would clear its alarms and triggers", applicationName, deploymentGroupName)
+							}
+
+							applications[applicationName][deploymentGroupName] = aws.ToString(params.DeploymentConfigName)
+
+							return middleware.InitializeOutput{Result: &codedeploy.UpdateDeploymentGroupOutput{}}, middleware.Metadata{}, nil
+						}
+
+						return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("unexpected operation input: %T", in.Parameters)
+					}), middleware.Before)
+			},
+		},
+	})
+}
+
+func TestFlattenTrafficRoutingConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject *types.TrafficRoutingConfig
+		expected  []interface{}
+	}{
+		"nil": {
+			expected: []interface{}{},
+		},
+		"all at once": {
+			apiObject: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeAllAtOnce,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					names.AttrType:      types.TrafficRoutingTypeAllAtOnce,
+					"time_based_canary": []interface{}{},
+					"time_based_linear": []interface{}{},
+				},
+			},
+		},
+		"all at once with zero-valued sub-blocks": {
+			apiObject: &types.TrafficRoutingConfig{
+				Type:            types.TrafficRoutingTypeAllAtOnce,
+				TimeBasedCanary: &types.TimeBasedCanary{},
+				TimeBasedLinear: &types.TimeBasedLinear{},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					names.AttrType:      types.TrafficRoutingTypeAllAtOnce,
+					"time_based_canary": []interface{}{},
+					"time_based_linear": []interface{}{},
+				},
+			},
+		},
+		"linear": {
+			apiObject: &types.TrafficRoutingConfig{
+				Type: types.TrafficRoutingTypeTimeBasedLinear,
+				TimeBasedLinear: &types.TimeBasedLinear{
+					LinearInterval:   1,
+					LinearPercentage: 10,
+				},
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					names.AttrType:      types.TrafficRoutingTypeTimeBasedLinear,
+					"time_based_canary": []interface{}{},
+					"time_based_linear": []interface{}{
+						map[string]interface{}{
+							names.AttrInterval: int32(1),
+							"percentage":       int32(10),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcodedeploy.FlattenTrafficRoutingConfig(testCase.apiObject)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeploymentConfigTrafficRoutingHash(t *testing.T) {
+	t.Parallel()
+
+	canary := func(interval, percentage int) map[string]interface{} {
+		return map[string]interface{}{
+			names.AttrType: "TimeBasedCanary",
+			"time_based_canary": []interface{}{
+				map[string]interface{}{
+					names.AttrInterval: interval,
+					"percentage":       percentage,
+				},
+			},
+			"time_based_linear": []interface{}{},
+		}
+	}
+	linear := func(interval, percentage int) map[string]interface{} {
+		return map[string]interface{}{
+			names.AttrType:      "TimeBasedLinear",
+			"time_based_canary": []interface{}{},
+			"time_based_linear": []interface{}{
+				map[string]interface{}{
+					names.AttrInterval: interval,
+					"percentage":       percentage,
+				},
+			},
+		}
+	}
+	allAtOnce := map[string]interface{}{
+		names.AttrType:      "AllAtOnce",
+		"time_based_canary": []interface{}{},
+		"time_based_linear": []interface{}{},
+	}
+
+	testCases := map[string]struct {
+		a, b  map[string]interface{}
+		equal bool
+	}{
+		"identical canary": {
+			a:     canary(5, 10),
+			b:     canary(5, 10),
+			equal: true,
+		},
+		"identical linear": {
+			a:     linear(1, 10),
+			b:     linear(1, 10),
+			equal: true,
+		},
+		"identical all at once": {
+			a:     allAtOnce,
+			b:     allAtOnce,
+			equal: true,
+		},
+		"canary interval differs": {
+			a: canary(5, 10),
+			b: canary(6, 10),
+		},
+		"canary percentage differs": {
+			a: canary(5, 10),
+			b: canary(5, 20),
+		},
+		"canary and linear with same settings": {
+			a: canary(5, 10),
+			b: linear(5, 10),
+		},
+		"interval and percentage swapped": {
+			a: linear(10, 20),
+			b: linear(20, 10),
+		},
+		"canary and all at once": {
+			a: canary(5, 10),
+			b: allAtOnce,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a, b := tfcodedeploy.DeploymentConfigTrafficRoutingHash(testCase.a), tfcodedeploy.DeploymentConfigTrafficRoutingHash(testCase.b)
+
+			if got := a == b; got != testCase.equal {
+				t.Errorf("hashes equal = %t (%d, %d), want %t", got, a, b, testCase.equal)
+			}
+		})
+	}
+}
+
+func TestTrafficRoutingConfigChanged(t *testing.T) {
+	t.Parallel()
+
+	canary := func(interval, percentage int) map[string]interface{} {
+		return map[string]interface{}{
+			names.AttrType: "TimeBasedCanary",
+			"time_based_canary": []interface{}{
+				map[string]interface{}{
+					names.AttrInterval: interval,
+					"percentage":       percentage,
+				},
+			},
+			"time_based_linear": []interface{}{},
+		}
+	}
+
+	testCases := map[string]struct {
+		o, n     []interface{}
+		expected bool
+	}{
+		"both empty": {
+			o: []interface{}{},
+			n: []interface{}{},
+		},
+		"added": {
+			o:        []interface{}{},
+			n:        []interface{}{canary(5, 10)},
+			expected: true,
+		},
+		"removed": {
+			o:        []interface{}{canary(5, 10)},
+			n:        []interface{}{},
+			expected: true,
+		},
+		"unchanged": {
+			o: []interface{}{canary(5, 10)},
+			n: []interface{}{canary(5, 10)},
+		},
+		"percentage changed": {
+			o:        []interface{}{canary(5, 10)},
+			n:        []interface{}{canary(5, 20)},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfcodedeploy.TrafficRoutingConfigChanged(testCase.o, testCase.n); got != testCase.expected {
+				t.Errorf("TrafficRoutingConfigChanged = %t, want %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFlattenZonalConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject *types.ZonalConfig
+		expected  []interface{}
+	}{
+		"nil": {
+			expected: []interface{}{},
+		},
+		"empty": {
+			apiObject: &types.ZonalConfig{},
+			expected:  []interface{}{},
+		},
+		"durations only": {
+			apiObject: &types.ZonalConfig{
+				FirstZoneMonitorDurationInSeconds: aws.Int64(30),
+				MonitorDurationInSeconds:          aws.Int64(60),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"first_zone_monitor_duration_in_seconds": int64(30),
+					"minimum_healthy_hosts_per_zone":         []interface{}{},
+					"monitor_duration_in_seconds":            int64(60),
+				},
+			},
+		},
+		"full": {
+			apiObject: &types.ZonalConfig{
+				FirstZoneMonitorDurationInSeconds: aws.Int64(30),
+				MinimumHealthyHostsPerZone: &types.MinimumHealthyHostsPerZone{
+					Type:  types.MinimumHealthyHostsPerZoneTypeFleetPercent,
+					Value: 20,
+				},
+				MonitorDurationInSeconds: aws.Int64(60),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"first_zone_monitor_duration_in_seconds": int64(30),
+					"minimum_healthy_hosts_per_zone": []interface{}{
+						map[string]interface{}{
+							names.AttrType:  types.MinimumHealthyHostsPerZoneTypeFleetPercent,
+							names.AttrValue: 20,
+						},
+					},
+					"monitor_duration_in_seconds": int64(60),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcodedeploy.FlattenZonalConfig(testCase.apiObject)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenMinimumHealthHostsPerZone(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject *types.MinimumHealthyHostsPerZone
+		expected  []interface{}
+	}{
+		"nil": {
+			expected: []interface{}{},
+		},
+		"empty": {
+			apiObject: &types.MinimumHealthyHostsPerZone{},
+			expected: []interface{}{
+				map[string]interface{}{
+					names.AttrType:  types.MinimumHealthyHostsPerZoneType(""),
+					names.AttrValue: 0,
+				},
+			},
+		},
+		"host count": {
+			apiObject: &types.MinimumHealthyHostsPerZone{
+				Type:  types.MinimumHealthyHostsPerZoneTypeHostCount,
+				Value: 2,
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					names.AttrType:  types.MinimumHealthyHostsPerZoneTypeHostCount,
+					names.AttrValue: 2,
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcodedeploy.FlattenMinimumHealthHostsPerZone(testCase.apiObject)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseMinimumHealthyHostsValueString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expectedType  types.MinimumHealthyHostsType
+		expectedValue int32
+		errorExpected bool
+	}{
+		"percent": {
+			input:         "75%",
+			expectedType:  types.MinimumHealthyHostsTypeFleetPercent,
+			expectedValue: 75,
+		},
+		"zero percent": {
+			input:         "0%",
+			expectedType:  types.MinimumHealthyHostsTypeFleetPercent,
+			expectedValue: 0,
+		},
+		"count": {
+			input:         "3",
+			expectedType:  types.MinimumHealthyHostsTypeHostCount,
+			expectedValue: 3,
+		},
+		"empty": {
+			input:         "",
+			errorExpected: true,
+		},
+		"percent only": {
+			input:         "%",
+			errorExpected: true,
+		},
+		"percent over 100": {
+			input:         "101%",
+			errorExpected: true,
+		},
+		"negative": {
+			input:         "-1",
+			errorExpected: true,
+		},
+		"double percent": {
+			input:         "75%%",
+			errorExpected: true,
+		},
+		"decimal": {
+			input:         "7.5%",
+			errorExpected: true,
+		},
+		"whitespace": {
+			input:         " 3",
+			errorExpected: true,
+		},
+		"text": {
+			input:         "three",
+			errorExpected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotType, gotValue, err := tfcodedeploy.ParseMinimumHealthyHostsValueString(testCase.input)
+
+			if err != nil && !testCase.errorExpected {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.errorExpected {
+				t.Fatal("expected error, got none")
+			}
+
+			if gotType != testCase.expectedType || gotValue != testCase.expectedValue {
+				t.Errorf("got (%s, %d), want (%s, %d)", gotType, gotValue, testCase.expectedType, testCase.expectedValue)
+			}
+		})
+	}
+}
+
+func TestMinimumHealthyHostsDescription(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject *types.MinimumHealthyHosts
+		expected  string
+	}{
+		"nil": {},
+		"fleet percent": {
+			apiObject: &types.MinimumHealthyHosts{
+				Type:  types.MinimumHealthyHostsTypeFleetPercent,
+				Value: 75,
+			},
+			expected: "75%",
+		},
+		"host count": {
+			apiObject: &types.MinimumHealthyHosts{
+				Type:  types.MinimumHealthyHostsTypeHostCount,
+				Value: 3,
+			},
+			expected: "3 hosts",
+		},
+		"single host": {
+			apiObject: &types.MinimumHealthyHosts{
+				Type:  types.MinimumHealthyHostsTypeHostCount,
+				Value: 1,
+			},
+			expected: "1 host",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfcodedeploy.MinimumHealthyHostsDescription(testCase.apiObject); got != testCase.expected {
+				t.Errorf("got %q, want %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestSetDeploymentConfigResourceData_minimumHealthyHostsShorthand(t *testing.T) {
+	t.Parallel()
+
+	d := tfcodedeploy.ResourceDeploymentConfig().Data(nil)
+	d.SetId("test")
+	d.Set("minimum_healthy_hosts_type", string(types.MinimumHealthyHostsTypeFleetPercent))
+	d.Set("minimum_healthy_hosts_value", 50)
+
+	apiObject := &types.DeploymentConfigInfo{
+		ComputePlatform:      types.ComputePlatformServer,
+		DeploymentConfigName: aws.String("test"),
+		MinimumHealthyHosts: &types.MinimumHealthyHosts{
+			Type:  types.MinimumHealthyHostsTypeFleetPercent,
+			Value: 75,
+		},
+	}
+
+	if err := tfcodedeploy.SetDeploymentConfigResourceData(acctest.Context(t), d, apiObject); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := d.Get("minimum_healthy_hosts_value").(int), 75; got != want {
+		t.Errorf("minimum_healthy_hosts_value = %d, want %d", got, want)
+	}
+
+	if got := len(d.Get("minimum_healthy_hosts").([]interface{})); got != 0 {
+		t.Errorf("minimum_healthy_hosts has %d elements, want 0", got)
+	}
+}
+
+func TestSetDeploymentConfigResourceData_configJSON(t *testing.T) {
+	t.Parallel()
+
+	d := tfcodedeploy.ResourceDeploymentConfig().Data(nil)
+	d.SetId("test")
+
+	apiObject := &types.DeploymentConfigInfo{
+		ComputePlatform:      types.ComputePlatformLambda,
+		DeploymentConfigName: aws.String("test"),
+		TrafficRoutingConfig: &types.TrafficRoutingConfig{
+			Type: types.TrafficRoutingTypeTimeBasedCanary,
+			TimeBasedCanary: &types.TimeBasedCanary{
+				CanaryInterval:   5,
+				CanaryPercentage: 10,
+			},
+		},
+	}
+
+	if err := tfcodedeploy.SetDeploymentConfigResourceData(acctest.Context(t), d, apiObject); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("config_json").(string)), &got); err != nil {
+		t.Fatalf("config_json is not valid JSON: %s", err)
+	}
+
+	want := map[string]interface{}{
+		"compute_platform":      "Lambda",
+		"minimum_healthy_hosts": []interface{}{},
+		"traffic_routing_config": []interface{}{
+			map[string]interface{}{
+				names.AttrType: "TimeBasedCanary",
+				"time_based_canary": []interface{}{
+					map[string]interface{}{
+						names.AttrInterval: float64(5),
+						"percentage":       float64(10),
+					},
+				},
+				"time_based_linear": []interface{}{},
+			},
+		},
+		"zonal_config": []interface{}{},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected config_json (+wanted, -got): %s", diff)
+	}
+}
+
+func TestSetDeploymentConfigRawJSON(t *testing.T) {
+	t.Parallel()
+
+	apiObject := &types.DeploymentConfigInfo{
+		ComputePlatform:      types.ComputePlatformServer,
+		DeploymentConfigId:   aws.String("id"),
+		DeploymentConfigName: aws.String("test"),
+		MinimumHealthyHosts: &types.MinimumHealthyHosts{
+			Type:  types.MinimumHealthyHostsTypeFleetPercent,
+			Value: 75,
+		},
+	}
+
+	testCases := map[string]struct {
+		enabled  bool
+		expected map[string]interface{}
+	}{
+		"disabled": {},
+		"enabled": {
+			enabled: true,
+			expected: map[string]interface{}{
+				"ComputePlatform":      "Server",
+				"DeploymentConfigId":   "id",
+				"DeploymentConfigName": "test",
+				"MinimumHealthyHosts": map[string]interface{}{
+					"Type":  "FLEET_PERCENT",
+					"Value": float64(75),
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := tfcodedeploy.ResourceDeploymentConfig().Data(nil)
+			d.SetId("test")
+
+			if err := tfcodedeploy.SetDeploymentConfigRawJSON(d, apiObject, testCase.enabled); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			v := d.Get("raw_config_json").(string)
+
+			if !testCase.enabled {
+				if v != "" {
+					t.Errorf("raw_config_json = %q, want empty", v)
+				}
+				return
+			}
+
+			var got map[string]interface{}
+			if err := json.Unmarshal([]byte(v), &got); err != nil {
+				t.Fatalf("raw_config_json is not valid JSON: %s", err)
+			}
+
+			for k, want := range testCase.expected {
+				if diff := cmp.Diff(got[k], want); diff != "" {
+					t.Errorf("unexpected %s (+wanted, -got): %s", k, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSetDeploymentConfigResourceData_unknownManagedConfig(t *testing.T) {
+	t.Parallel()
+
+	// A managed config added after this provider's SDK was released, using values not yet in the SDK's enums.
+	const name = "CodeDeployDefault.NewlyLaunched"
+	d := tfcodedeploy.ResourceDeploymentConfig().Data(nil)
+	d.SetId(name)
+
+	apiObject := &types.DeploymentConfigInfo{
+		ComputePlatform:      types.ComputePlatform("NewPlatform"),
+		DeploymentConfigName: aws.String(name),
+		TrafficRoutingConfig: &types.TrafficRoutingConfig{
+			Type: types.TrafficRoutingType("NewRoutingType"),
+		},
+		ZonalConfig: &types.ZonalConfig{
+			MinimumHealthyHostsPerZone: &types.MinimumHealthyHostsPerZone{
+				Type:  types.MinimumHealthyHostsPerZoneType("NEW_TYPE"),
+				Value: 1,
+			},
+		},
+	}
+
+	if err := tfcodedeploy.SetDeploymentConfigResourceData(acctest.Context(t), d, apiObject); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := d.Get("deployment_config_name").(string); got != name {
+		t.Errorf("deployment_config_name = %q, want %q", got, name)
+	}
+
+	if got, want := d.Get("compute_platform").(string), "NewPlatform"; got != want {
+		t.Errorf("compute_platform = %q, want %q", got, want)
+	}
+
+	if got, want := d.Get("traffic_routing_config.0.type").(string), "NewRoutingType"; got != want {
+		t.Errorf("traffic_routing_config.0.type = %q, want %q", got, want)
+	}
+
+	if got, want := d.Get("zonal_config.0.minimum_healthy_hosts_per_zone.0.type").(string), "NEW_TYPE"; got != want {
+		t.Errorf("zonal_config.0.minimum_healthy_hosts_per_zone.0.type = %q, want %q", got, want)
+	}
+}
+
+func TestSetDeploymentConfigResourceData_noComputePlatform(t *testing.T) {
+	t.Parallel()
+
+	d := tfcodedeploy.ResourceDeploymentConfig().Data(nil)
+	d.SetId("test")
+	d.Set("compute_platform", string(types.ComputePlatformLambda))
+
+	apiObject := &types.DeploymentConfigInfo{
+		DeploymentConfigId:   aws.String("00000000-0000-0000-0000-000000000000"),
+		DeploymentConfigName: aws.String("test"),
+		TrafficRoutingConfig: &types.TrafficRoutingConfig{
+			Type: types.TrafficRoutingTypeAllAtOnce,
+		},
+	}
+
+	if err := tfcodedeploy.SetDeploymentConfigResourceData(acctest.Context(t), d, apiObject); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := d.Get("compute_platform").(string), string(types.ComputePlatformLambda); got != want {
+		t.Errorf("compute_platform = %q, want %q", got, want)
+	}
+
+	if got, want := d.Get("deployment_config_id").(string), aws.ToString(apiObject.DeploymentConfigId); got != want {
+		t.Errorf("deployment_config_id = %q, want %q", got, want)
+	}
+
+	apiObject.ComputePlatform = types.ComputePlatformEcs
+
+	if err := tfcodedeploy.SetDeploymentConfigResourceData(acctest.Context(t), d, apiObject); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := d.Get("compute_platform").(string), string(types.ComputePlatformEcs); got != want {
+		t.Errorf("compute_platform = %q, want %q", got, want)
+	}
+}
+
+func TestSetDeploymentConfigResourceData_noComputePlatformLogFields(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+
+	d := tfcodedeploy.ResourceDeploymentConfig().Data(nil)
+	d.SetId("test")
+
+	apiObject := &types.DeploymentConfigInfo{
+		DeploymentConfigName: aws.String("test"),
+	}
+
+	if err := tfcodedeploy.SetDeploymentConfigResourceData(ctx, d, apiObject); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	lines, err := tflogtest.MultilineJSONDecode(&buf)
+	if err != nil {
+		t.Fatalf("decoding log lines: %s", err)
+	}
+
+	if got, want := len(lines), 1; got != want {
+		t.Fatalf("log lines = %d, want %d", got, want)
+	}
+
+	if got, want := lines[0]["@level"], "warn"; got != want {
+		t.Errorf("@level = %v, want %q", got, want)
+	}
+
+	if got, want := lines[0]["deployment_config_name"], "test"; got != want {
+		t.Errorf("deployment_config_name = %v, want %q", got, want)
+	}
+}
+
 func TestAccDeployDeploymentConfig_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var config types.DeploymentConfigInfo
@@ -34,14 +2540,552 @@ func TestAccDeployDeploymentConfig_basic(t *testing.T) {
 		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDeploymentConfigConfig_fleet(rName, 75),
+				Config: testAccDeploymentConfigConfig_fleet(rName, 75),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "deployment_config_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", "0"),
+					acctest.CheckResourceAttrEquivalentJSON(resourceName, "config_json", `{"compute_platform":"Server","minimum_healthy_hosts":[{"type":"FLEET_PERCENT","value":75}],"traffic_routing_config":[],"zonal_config":[]}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_namePrefixSuffix(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_namePrefixSuffix("tf-acc-test-prefix-", "-suffix"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
+					resource.TestMatchResourceAttr(resourceName, "deployment_config_name", regexache.MustCompile(`^tf-acc-test-prefix-[[:xdigit:]]{26}-suffix$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, "tf-acc-test-prefix-"),
+					resource.TestCheckResourceAttr(resourceName, "name_suffix", "-suffix"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrNamePrefix, "name_suffix"},
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_namePrefixSuffixTooLong(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentConfigConfig_namePrefixSuffix(strings.Repeat("p", 50), strings.Repeat("s", 50)),
+				ExpectError: regexache.MustCompile(`must be at most 100 characters`),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_managedNamePrefix(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := "CodeDeployDefault." + sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentConfigConfig_fleet(rName, 75),
+				ExpectError: regexache.MustCompile(`reserved for AWS-managed deployment configs`),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_fleet(rName, 75),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcodedeploy.ResourceDeploymentConfig(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_createBeforeDestroyRename(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config1, config2 types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	groupResourceName := "aws_codedeploy_deployment_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_createBeforeDestroy(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
+					resource.TestCheckResourceAttr(resourceName, "deployment_config_name", rName),
+					resource.TestCheckResourceAttr(groupResourceName, "deployment_config_name", rName),
+				),
+			},
+			{
+				Config: testAccDeploymentConfigConfig_createBeforeDestroy(rName, rNameUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
+					testAccCheckDeploymentConfigRecreated(&config1, &config2),
+					resource.TestCheckResourceAttr(resourceName, "deployment_config_name", rNameUpdated),
+					resource.TestCheckResourceAttr(groupResourceName, "deployment_config_name", rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_fleetPercent(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config1, config2 types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_fleet(rName, 75),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.type", "FLEET_PERCENT"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.value", "75"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts_description", "75%"),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "0"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfigConfig_fleet(rName, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
+					testAccCheckDeploymentConfigRecreated(&config1, &config2),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.type", "FLEET_PERCENT"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.value", "50"),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_minimumHealthyHostsShorthand(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_minimumHealthyHostsShorthand(rName, "HOST_COUNT", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts_type", "HOST_COUNT"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts_value", "2"),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"minimum_healthy_hosts", "minimum_healthy_hosts_type", "minimum_healthy_hosts_value"},
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_minimumHealthyHostsValueString(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_minimumHealthyHostsValueString(rName, "75%"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.type", "FLEET_PERCENT"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.value", "75"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.value_string", "75%"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"minimum_healthy_hosts.0.value_string"},
+			},
+			{
+				Config:      testAccDeploymentConfigConfig_minimumHealthyHostsValueStringType(rName, "3", "FLEET_PERCENT"),
+				ExpectError: regexache.MustCompile(`implies type HOST_COUNT, which conflicts with the configured type FLEET_PERCENT`),
+			},
+			{
+				Config:      testAccDeploymentConfigConfig_minimumHealthyHostsValueString(rName, "three"),
+				ExpectError: regexache.MustCompile(`is neither a host count`),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_minimumHealthyHostsConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentConfigConfig_minimumHealthyHostsConflict(rName),
+				ExpectError: regexache.MustCompile(`"minimum_healthy_hosts_type": conflicts with minimum_healthy_hosts`),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_hostCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config1, config2 types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_hostCount(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.type", "HOST_COUNT"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.value", "1"),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "0"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfigConfig_hostCount(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
+					testAccCheckDeploymentConfigRecreated(&config1, &config2),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.type", "HOST_COUNT"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.value", "2"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts_description", "2 hosts"),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_trafficCanary(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config1, config2 types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_trafficCanary(rName, 10, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Lambda"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.type", "TimeBasedCanary"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.interval", "10"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfigConfig_trafficCanary(rName, 3, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
+					testAccCheckDeploymentConfigRecreated(&config1, &config2),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Lambda"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.type", "TimeBasedCanary"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.interval", "3"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.percentage", "10"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_trafficRoutingUpdateInUse(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config1, config2 types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	deploymentGroupResourceName := "aws_codedeploy_deployment_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_trafficCanaryInUse(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.percentage", "10"),
+					resource.TestCheckResourceAttr(deploymentGroupResourceName, "deployment_config_name", rName),
+				),
+			},
+			{
+				Config: testAccDeploymentConfigConfig_trafficCanaryInUse(rName, 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction(deploymentGroupResourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
+					testAccCheckDeploymentConfigRecreated(&config1, &config2),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.percentage", "20"),
+					resource.TestCheckResourceAttr(deploymentGroupResourceName, "deployment_config_name", rName),
+				),
+			},
+			{
+				Config: testAccDeploymentConfigConfig_trafficCanaryInUse(rName, 20),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_trafficLinear(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config1, config2 types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_trafficLinear(rName, 10, 50),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Lambda"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.type", "TimeBasedLinear"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.interval", "10"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.percentage", "50"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfigConfig_trafficLinear(rName, 3, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
+					testAccCheckDeploymentConfigRecreated(&config1, &config2),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Lambda"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.type", "TimeBasedLinear"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.interval", "3"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.percentage", "10"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_trafficAllAtOnce(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_trafficAllAtOnce(rName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
-					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
-					resource.TestCheckResourceAttr(resourceName, "deployment_config_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Lambda"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.type", "AllAtOnce"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_zonalConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config1, config2 types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_zonalConfig(rName, 10, "FLEET_PERCENT", 20, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
 					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.first_zone_monitor_duration_in_seconds", "10"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.type", "FLEET_PERCENT"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.value", "20"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.monitor_duration_in_seconds", "10"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfigConfig_zonalConfig(rName, 20, "HOST_COUNT", 2, 20),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.first_zone_monitor_duration_in_seconds", "20"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.type", "HOST_COUNT"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.value", "2"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.monitor_duration_in_seconds", "20"),
 				),
 			},
 			{
@@ -53,7 +3097,7 @@ func TestAccDeployDeploymentConfig_basic(t *testing.T) {
 	})
 }
 
-func TestAccDeployDeploymentConfig_disappears(t *testing.T) {
+func TestAccDeployDeploymentConfig_usedByDeploymentGroups(t *testing.T) {
 	ctx := acctest.Context(t)
 	var config types.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
@@ -66,20 +3110,27 @@ func TestAccDeployDeploymentConfig_disappears(t *testing.T) {
 		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDeploymentConfigConfig_fleet(rName, 75),
+				Config: testAccDeploymentConfigConfig_usedByDeploymentGroups(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcodedeploy.ResourceDeploymentConfig(), resourceName),
+					resource.TestCheckResourceAttr(resourceName, "include_used_by_deployment_groups", acctest.CtTrue),
+				),
+			},
+			// The deployment group is created after the config, so it is only listed once the config is refreshed.
+			{
+				Config: testAccDeploymentConfigConfig_usedByDeploymentGroups(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "used_by_deployment_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "used_by_deployment_groups.0", rName+":"+rName),
 				),
-				ExpectNonEmptyPlan: true,
 			},
 		},
 	})
 }
 
-func TestAccDeployDeploymentConfig_fleetPercent(t *testing.T) {
+func TestAccDeployDeploymentConfig_includeRawAPIResponses(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config1, config2 types.DeploymentConfigInfo
+	var config types.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -90,40 +3141,57 @@ func TestAccDeployDeploymentConfig_fleetPercent(t *testing.T) {
 		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDeploymentConfigConfig_fleet(rName, 75),
+				Config: testAccDeploymentConfigConfig_includeRawAPIResponses(rName, true),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.type", "FLEET_PERCENT"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.value", "75"),
-					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "0"),
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "include_raw_api_responses", acctest.CtTrue),
+					resource.TestCheckResourceAttrWith(resourceName, "raw_config_json", func(value string) error {
+						var v map[string]interface{}
+						if err := json.Unmarshal([]byte(value), &v); err != nil {
+							return err
+						}
+
+						if got, want := v["DeploymentConfigName"], rName; got != want {
+							return fmt.Errorf("DeploymentConfigName = %v, want %s", got, want)
+						}
+
+						return nil
+					}),
 				),
 			},
 			{
-				Config: testAccDeploymentConfigConfig_fleet(rName, 50),
+				Config: testAccDeploymentConfigConfig_includeRawAPIResponses(rName, false),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
-					testAccCheckDeploymentConfigRecreated(&config1, &config2),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.type", "FLEET_PERCENT"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.value", "50"),
-					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "0"),
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "include_raw_api_responses", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "raw_config_json", ""),
 				),
 			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_ECS_zonalConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config:      testAccDeploymentConfigConfig_ecsZonalConfig(rName),
+				ExpectError: regexache.MustCompile(`zonal_config is not supported for the ECS compute platform`),
 			},
 		},
 	})
 }
 
-func TestAccDeployDeploymentConfig_hostCount(t *testing.T) {
+func TestAccDeployDeploymentConfig_importNestedBlocks(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config1, config2 types.DeploymentConfigInfo
+	var config types.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -134,28 +3202,74 @@ func TestAccDeployDeploymentConfig_hostCount(t *testing.T) {
 		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDeploymentConfigConfig_hostCount(rName, 1),
+				Config: testAccDeploymentConfigConfig_zonalConfig(rName, 10, "FLEET_PERCENT", 20, 10),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.type", "HOST_COUNT"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.value", "1"),
-					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "0"),
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
 				),
 			},
 			{
-				Config: testAccDeploymentConfigConfig_hostCount(rName, 2),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
-					testAccCheckDeploymentConfigRecreated(&config1, &config2),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.type", "HOST_COUNT"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.value", "2"),
+				ResourceName: resourceName,
+				ImportState:  true,
+				ImportStateCheck: acctest.ComposeAggregateImportStateCheckFunc(
+					acctest.ImportCheckResourceAttr("compute_platform", "Server"),
+					acctest.ImportCheckResourceAttr("deployment_config_name", rName),
+					acctest.ImportCheckResourceAttr("minimum_healthy_hosts.#", "1"),
+					acctest.ImportCheckResourceAttr("minimum_healthy_hosts.0.type", "FLEET_PERCENT"),
+					acctest.ImportCheckResourceAttrSet("minimum_healthy_hosts.0.value", true),
+					acctest.ImportCheckResourceAttr("traffic_routing_config.#", "0"),
+					acctest.ImportCheckResourceAttr("zonal_config.#", "1"),
+					acctest.ImportCheckResourceAttr("zonal_config.0.first_zone_monitor_duration_in_seconds", "10"),
+					acctest.ImportCheckResourceAttr("zonal_config.0.minimum_healthy_hosts_per_zone.#", "1"),
+					acctest.ImportCheckResourceAttr("zonal_config.0.minimum_healthy_hosts_per_zone.0.type", "FLEET_PERCENT"),
+					acctest.ImportCheckResourceAttr("zonal_config.0.minimum_healthy_hosts_per_zone.0.value", "20"),
+					acctest.ImportCheckResourceAttr("zonal_config.0.monitor_duration_in_seconds", "10"),
+				),
+			},
+			{
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "does-not-exist",
+				ExpectError:   regexache.MustCompile(`reading CodeDeploy Deployment Config \(does-not-exist\)`),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_server(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_server(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
 					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.type", "FLEET_PERCENT"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.0.value", "75"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.type", "HOST_COUNT"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.value", "1"),
 				),
 			},
+			{
+				Config: testAccDeploymentConfigConfig_server(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -165,9 +3279,9 @@ func TestAccDeployDeploymentConfig_hostCount(t *testing.T) {
 	})
 }
 
-func TestAccDeployDeploymentConfig_trafficCanary(t *testing.T) {
+func TestAccDeployDeploymentConfig_providerDefaultComputePlatform(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config1, config2 types.DeploymentConfigInfo
+	var config types.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -178,34 +3292,60 @@ func TestAccDeployDeploymentConfig_trafficCanary(t *testing.T) {
 		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDeploymentConfigConfig_trafficCanary(rName, 10, 50),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
+				Config: testAccDeploymentConfigConfig_providerDefaultComputePlatform(rName, "Lambda"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
 					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Lambda"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.type", "TimeBasedCanary"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.interval", "10"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.percentage", "50"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
 				),
 			},
 			{
-				Config: testAccDeploymentConfigConfig_trafficCanary(rName, 3, 10),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
-					testAccCheckDeploymentConfigRecreated(&config1, &config2),
+				Config: testAccDeploymentConfigConfig_providerDefaultComputePlatform(rName, "Lambda"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_lambda(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_lambda(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
 					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Lambda"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.type", "TimeBasedCanary"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.interval", "3"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.interval", "5"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.percentage", "10"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", "0"),
 				),
 			},
+			{
+				Config: testAccDeploymentConfigConfig_lambda(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -215,9 +3355,9 @@ func TestAccDeployDeploymentConfig_trafficCanary(t *testing.T) {
 	})
 }
 
-func TestAccDeployDeploymentConfig_trafficLinear(t *testing.T) {
+func TestAccDeployDeploymentConfig_ecs(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config1, config2 types.DeploymentConfigInfo
+	var config types.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -228,34 +3368,71 @@ func TestAccDeployDeploymentConfig_trafficLinear(t *testing.T) {
 		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDeploymentConfigConfig_trafficLinear(rName, 10, 50),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
-					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Lambda"),
+				Config: testAccDeploymentConfigConfig_ecs(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "ECS"),
+					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.type", "TimeBasedLinear"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.interval", "10"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.percentage", "50"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.interval", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.percentage", "20"),
+					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", "0"),
 				),
 			},
 			{
-				Config: testAccDeploymentConfigConfig_trafficLinear(rName, 3, 10),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
-					testAccCheckDeploymentConfigRecreated(&config1, &config2),
-					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Lambda"),
+				Config: testAccDeploymentConfigConfig_ecs(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfig_ECS_timeBasedLinear(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config types.DeploymentConfigInfo
+	resourceName := "aws_codedeploy_deployment_config.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigConfig_ecsTrafficRouting(rName, "TimeBasedLinear", "time_based_linear", 1, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
+					testAccCheckDeploymentConfigTrafficRoutingType(&config, types.TrafficRoutingTypeTimeBasedLinear),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "ECS"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.type", "TimeBasedLinear"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.#", "0"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.interval", "3"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.interval", "1"),
 					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.0.percentage", "10"),
-					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.#", "0"),
-					resource.TestCheckResourceAttr(resourceName, "minimum_healthy_hosts.#", "0"),
 				),
 			},
+			{
+				Config: testAccDeploymentConfigConfig_ecsTrafficRouting(rName, "TimeBasedLinear", "time_based_linear", 1, 10),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
@@ -265,9 +3442,9 @@ func TestAccDeployDeploymentConfig_trafficLinear(t *testing.T) {
 	})
 }
 
-func TestAccDeployDeploymentConfig_zonalConfig(t *testing.T) {
+func TestAccDeployDeploymentConfig_ECS_timeBasedCanary(t *testing.T) {
 	ctx := acctest.Context(t)
-	var config1, config2 types.DeploymentConfigInfo
+	var config types.DeploymentConfigInfo
 	resourceName := "aws_codedeploy_deployment_config.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -278,30 +3455,26 @@ func TestAccDeployDeploymentConfig_zonalConfig(t *testing.T) {
 		CheckDestroy:             testAccCheckDeploymentConfigDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccDeploymentConfigConfig_zonalConfig(rName, 10, "FLEET_PERCENT", 20, 10),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentConfigExists(ctx, resourceName, &config1),
-					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.first_zone_monitor_duration_in_seconds", "10"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.type", "FLEET_PERCENT"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.value", "20"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.monitor_duration_in_seconds", "10"),
+				Config: testAccDeploymentConfigConfig_ecsTrafficRouting(rName, "TimeBasedCanary", "time_based_canary", 5, 10),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentConfigExists(ctx, resourceName, &config),
+					testAccCheckDeploymentConfigTrafficRoutingType(&config, types.TrafficRoutingTypeTimeBasedCanary),
+					resource.TestCheckResourceAttr(resourceName, "compute_platform", "ECS"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.type", "TimeBasedCanary"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.interval", "5"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_canary.0.percentage", "10"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_routing_config.0.time_based_linear.#", "0"),
 				),
 			},
 			{
-				Config: testAccDeploymentConfigConfig_zonalConfig(rName, 20, "HOST_COUNT", 2, 20),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentConfigExists(ctx, resourceName, &config2),
-					resource.TestCheckResourceAttr(resourceName, "compute_platform", "Server"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.first_zone_monitor_duration_in_seconds", "20"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.#", "1"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.type", "HOST_COUNT"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.minimum_healthy_hosts_per_zone.0.value", "2"),
-					resource.TestCheckResourceAttr(resourceName, "zonal_config.0.monitor_duration_in_seconds", "20"),
-				),
+				Config: testAccDeploymentConfigConfig_ecsTrafficRouting(rName, "TimeBasedCanary", "time_based_canary", 5, 10),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
 			},
 			{
 				ResourceName:      resourceName,
@@ -359,6 +3532,20 @@ func testAccCheckDeploymentConfigExists(ctx context.Context, n string, v *types.
 	}
 }
 
+func testAccCheckDeploymentConfigTrafficRoutingType(v *types.DeploymentConfigInfo, want types.TrafficRoutingType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if v.TrafficRoutingConfig == nil {
+			return errors.New("CodeDeploy Deployment Config has no traffic routing config")
+		}
+
+		if got := v.TrafficRoutingConfig.Type; got != want {
+			return fmt.Errorf("CodeDeploy Deployment Config traffic routing type = %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckDeploymentConfigRecreated(i, j *types.DeploymentConfigInfo) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if aws.ToTime(i.CreateTime).Equal(aws.ToTime(j.CreateTime)) {
@@ -382,6 +3569,121 @@ resource "aws_codedeploy_deployment_config" "test" {
 `, rName, value)
 }
 
+func testAccDeploymentConfigConfig_createBeforeDestroy(rName, configName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[2]q
+
+  minimum_healthy_hosts {
+    type  = "FLEET_PERCENT"
+    value = 75
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_codedeploy_app" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "codedeploy.${data.aws_partition.current.dns_suffix}"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name               = aws_codedeploy_app.test.name
+  deployment_group_name  = %[1]q
+  deployment_config_name = aws_codedeploy_deployment_config.test.id
+  service_role_arn       = aws_iam_role.test.arn
+}
+`, rName, configName)
+}
+
+func testAccDeploymentConfigConfig_namePrefixSuffix(namePrefix, nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  name_prefix = %[1]q
+  name_suffix = %[2]q
+
+  minimum_healthy_hosts {
+    type  = "FLEET_PERCENT"
+    value = 75
+  }
+}
+`, namePrefix, nameSuffix)
+}
+
+func testAccDeploymentConfigConfig_minimumHealthyHostsShorthand(rName, typ string, value int) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name      = %[1]q
+  minimum_healthy_hosts_type  = %[2]q
+  minimum_healthy_hosts_value = %[3]d
+}
+`, rName, typ, value)
+}
+
+func testAccDeploymentConfigConfig_minimumHealthyHostsValueString(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+
+  minimum_healthy_hosts {
+    value_string = %[2]q
+  }
+}
+`, rName, value)
+}
+
+func testAccDeploymentConfigConfig_minimumHealthyHostsValueStringType(rName, value, typ string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+
+  minimum_healthy_hosts {
+    type         = %[3]q
+    value_string = %[2]q
+  }
+}
+`, rName, value, typ)
+}
+
+func testAccDeploymentConfigConfig_minimumHealthyHostsConflict(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name      = %[1]q
+  minimum_healthy_hosts_type  = "HOST_COUNT"
+  minimum_healthy_hosts_value = 2
+
+  minimum_healthy_hosts {
+    type  = "HOST_COUNT"
+    value = 2
+  }
+}
+`, rName)
+}
+
 func testAccDeploymentConfigConfig_hostCount(rName string, value int) string {
 	return fmt.Sprintf(`
 resource "aws_codedeploy_deployment_config" "test" {
@@ -413,6 +3715,64 @@ resource "aws_codedeploy_deployment_config" "test" {
 `, rName, interval, percentage)
 }
 
+func testAccDeploymentConfigConfig_trafficCanaryInUse(rName string, percentage int) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = "Lambda"
+
+  traffic_routing_config {
+    type = "TimeBasedCanary"
+
+    time_based_canary {
+      interval   = 10
+      percentage = %[2]d
+    }
+  }
+}
+
+resource "aws_codedeploy_app" "test" {
+  name             = %[1]q
+  compute_platform = "Lambda"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "codedeploy.${data.aws_partition.current.dns_suffix}"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name               = aws_codedeploy_app.test.name
+  deployment_group_name  = %[1]q
+  deployment_config_name = aws_codedeploy_deployment_config.test.id
+  service_role_arn       = aws_iam_role.test.arn
+
+  deployment_style {
+    deployment_option = "WITH_TRAFFIC_CONTROL"
+    deployment_type   = "BLUE_GREEN"
+  }
+}
+`, rName, percentage)
+}
+
 func testAccDeploymentConfigConfig_trafficLinear(rName string, interval, percentage int) string {
 	return fmt.Sprintf(`
 resource "aws_codedeploy_deployment_config" "test" {
@@ -431,6 +3791,19 @@ resource "aws_codedeploy_deployment_config" "test" {
 `, rName, interval, percentage)
 }
 
+func testAccDeploymentConfigConfig_trafficAllAtOnce(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = "Lambda"
+
+  traffic_routing_config {
+    type = "AllAtOnce"
+  }
+}
+`, rName)
+}
+
 func testAccDeploymentConfigConfig_zonalConfig(rName string, first_zone_monitor_duration int, minimum_healthy_host_type string, minimum_healthy_host_value int, monitor_duration int) string {
 	return fmt.Sprintf(`
 resource "aws_codedeploy_deployment_config" "test" {
@@ -453,3 +3826,156 @@ resource "aws_codedeploy_deployment_config" "test" {
 }
 `, rName, first_zone_monitor_duration, minimum_healthy_host_type, minimum_healthy_host_value, monitor_duration)
 }
+
+func testAccDeploymentConfigConfig_server(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = "Server"
+
+  minimum_healthy_hosts {
+    type  = "FLEET_PERCENT"
+    value = 75
+  }
+
+  zonal_config {
+    monitor_duration_in_seconds = 60
+
+    minimum_healthy_hosts_per_zone {
+      type  = "HOST_COUNT"
+      value = 1
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDeploymentConfigConfig_providerDefaultComputePlatform(rName, computePlatform string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  codedeploy_default_compute_platform = %[2]q
+}
+
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+
+  traffic_routing_config {
+    type = "TimeBasedCanary"
+
+    time_based_canary {
+      interval   = 5
+      percentage = 10
+    }
+  }
+}
+`, rName, computePlatform)
+}
+
+func testAccDeploymentConfigConfig_lambda(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = "Lambda"
+
+  traffic_routing_config {
+    type = "TimeBasedCanary"
+
+    time_based_canary {
+      interval   = 5
+      percentage = 10
+    }
+  }
+}
+`, rName)
+}
+
+func testAccDeploymentConfigConfig_ecs(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = "ECS"
+
+  traffic_routing_config {
+    type = "TimeBasedLinear"
+
+    time_based_linear {
+      interval   = 1
+      percentage = 20
+    }
+  }
+}
+
+`, rName)
+}
+
+func testAccDeploymentConfigConfig_ecsTrafficRouting(rName, typ, block string, interval, percentage int) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = "ECS"
+
+  traffic_routing_config {
+    type = %[2]q
+
+    %[3]s {
+      interval   = %[4]d
+      percentage = %[5]d
+    }
+  }
+}
+`, rName, typ, block, interval, percentage)
+}
+
+func testAccDeploymentConfigConfig_usedByDeploymentGroups(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name            = %[1]q
+  include_used_by_deployment_groups = true
+
+  minimum_healthy_hosts {
+    type  = "HOST_COUNT"
+    value = 1
+  }
+}
+
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name               = aws_codedeploy_app.test.name
+  deployment_group_name  = %[1]q
+  deployment_config_name = aws_codedeploy_deployment_config.test.id
+  service_role_arn       = aws_iam_role.test.arn
+}
+`, rName))
+}
+
+func testAccDeploymentConfigConfig_includeRawAPIResponses(rName string, includeRawAPIResponses bool) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name    = %[1]q
+  include_raw_api_responses = %[2]t
+
+  minimum_healthy_hosts {
+    type  = "HOST_COUNT"
+    value = 1
+  }
+}
+`, rName, includeRawAPIResponses)
+}
+
+func testAccDeploymentConfigConfig_ecsZonalConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = "ECS"
+
+  zonal_config {
+    monitor_duration_in_seconds = 10
+
+    minimum_healthy_hosts_per_zone {
+      type  = "FLEET_PERCENT"
+      value = 20
+    }
+  }
+}
+`, rName)
+}
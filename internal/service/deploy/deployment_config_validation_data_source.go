@@ -0,0 +1,165 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deploy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_codedeploy_deployment_config_validation", name="Deployment Config Validation")
+func dataSourceDeploymentConfigValidation() *schema.Resource {
+	// The arguments are aws_codedeploy_deployment_config's, so that a resource's configuration can be validated as-is.
+	// Their validation is left to Read so that problems are reported in errors rather than failing the plan.
+	s := make(map[string]*schema.Schema)
+	for k, v := range resourceDeploymentConfig().SchemaMap() {
+		if !v.Optional && !v.Required {
+			continue
+		}
+		s[k] = schemaWithoutValidation(v)
+	}
+	delete(s, "include_raw_api_responses")
+	delete(s, "include_used_by_deployment_groups")
+
+	// A generated name would differ on every read, so the name to validate must be set.
+	delete(s, names.AttrNamePrefix)
+	delete(s, "name_suffix")
+	s["deployment_config_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+
+	s["errors"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	s["valid"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDeploymentConfigValidationRead,
+
+		Schema: s,
+	}
+}
+
+func dataSourceDeploymentConfigValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input := &codedeploy.CreateDeploymentConfigInput{
+		ComputePlatform:      types.ComputePlatform(d.Get("compute_platform").(string)),
+		DeploymentConfigName: aws.String(d.Get("deployment_config_name").(string)),
+		MinimumHealthyHosts:  expandMinimumHealthyHosts(d),
+		TrafficRoutingConfig: expandTrafficRoutingConfig(d),
+		ZonalConfig:          expandZonalConfig(d),
+	}
+
+	// No API calls are made. The configuration is checked as aws_codedeploy_deployment_config checks it
+	// when validating, planning and creating the config, but every failure is collected.
+	rawConfig := d.GetRawConfig()
+	failures := deploymentConfigSchemaValidationErrors(rawConfig)
+	failures = tfslices.AppendUnique(failures, deploymentConfigValidationErrors(validateMinimumHealthyHostsValueStringType(rawConfig.GetAttr("minimum_healthy_hosts")))...)
+	failures = tfslices.AppendUnique(failures, deploymentConfigValidationErrors(validateTrafficRoutingTypeConfigured(rawConfig.GetAttr("traffic_routing_config")))...)
+	failures = tfslices.AppendUnique(failures, deploymentConfigValidationErrors(validateCreateDeploymentConfigInput(input))...)
+
+	d.SetId(aws.ToString(input.DeploymentConfigName))
+	d.Set("compute_platform", input.ComputePlatform)
+	d.Set("deployment_config_name", input.DeploymentConfigName)
+	d.Set("errors", failures)
+	d.Set("valid", len(failures) == 0)
+
+	return diags
+}
+
+// deploymentConfigValidationErrors returns the messages of the individual failures joined into err.
+// Failures that are themselves joined errors are flattened.
+func deploymentConfigValidationErrors(err error) []string {
+	if err == nil {
+		return []string{}
+	}
+
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) {
+		return []string{err.Error()}
+	}
+
+	var failures []string
+	for _, err := range joined.Unwrap() {
+		failures = append(failures, deploymentConfigValidationErrors(err)...)
+	}
+
+	return failures
+}
+
+// deploymentConfigSchemaValidationErrors returns the messages of the aws_codedeploy_deployment_config schema
+// validation failures, such as out of range values or conflicting arguments, for the data source's configuration.
+func deploymentConfigSchemaValidationErrors(rawConfig cty.Value) []string {
+	r := resourceDeploymentConfig()
+	block := r.CoreConfigSchema()
+
+	// Arguments that the data source doesn't have are null.
+	attrs := make(map[string]cty.Value)
+	for k, v := range block.ImpliedType().AttributeTypes() {
+		if rawConfig.Type().HasAttribute(k) {
+			attrs[k] = rawConfig.GetAttr(k)
+		} else {
+			attrs[k] = cty.NullVal(v)
+		}
+	}
+
+	failures := []string{}
+	for _, v := range r.Validate(terraform.NewResourceConfigShimmed(cty.ObjectVal(attrs), block)) {
+		if v.Severity != diag.Error {
+			continue
+		}
+
+		if v.Detail != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s", v.Summary, v.Detail))
+		} else {
+			failures = append(failures, v.Summary)
+		}
+	}
+
+	// Schema validation visits arguments in no particular order.
+	slices.Sort(failures)
+
+	return failures
+}
+
+// schemaWithoutValidation returns a copy of v, and of any nested schema, with ForceNew and validation unset.
+func schemaWithoutValidation(v *schema.Schema) *schema.Schema {
+	output := *v
+	output.AtLeastOneOf = nil
+	output.ConflictsWith = nil
+	output.ExactlyOneOf = nil
+	output.ForceNew = false
+	output.RequiredWith = nil
+	output.ValidateDiagFunc = nil
+	output.ValidateFunc = nil
+
+	if elem, ok := v.Elem.(*schema.Resource); ok {
+		s := make(map[string]*schema.Schema, len(elem.Schema))
+		for k, v := range elem.Schema {
+			s[k] = schemaWithoutValidation(v)
+		}
+		output.Elem = &schema.Resource{Schema: s}
+	}
+
+	return &output
+}
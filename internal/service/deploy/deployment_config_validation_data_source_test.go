@@ -0,0 +1,243 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deploy_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcodedeploy "github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDeploymentConfigValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		err      error
+		expected []string
+	}{
+		"nil": {
+			expected: []string{},
+		},
+		"single": {
+			err:      errors.New("a"),
+			expected: []string{"a"},
+		},
+		"joined": {
+			err:      errors.Join(errors.New("a"), errors.New("b")),
+			expected: []string{"a", "b"},
+		},
+		"nested": {
+			err:      errors.Join(errors.New("a"), errors.Join(errors.New("b"), errors.Join(errors.New("c"))), errors.New("d")),
+			expected: []string{"a", "b", "c", "d"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcodedeploy.DeploymentConfigValidationErrors(testCase.err)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDeploymentConfigSchemaValidationErrors(t *testing.T) {
+	t.Parallel()
+
+	intervalPercentageType := cty.Object(map[string]cty.Type{
+		names.AttrInterval: cty.Number,
+		"percentage":       cty.Number,
+	})
+
+	testCases := map[string]struct {
+		config   map[string]cty.Value
+		expected []string
+	}{
+		"valid": {
+			config: map[string]cty.Value{
+				"deployment_config_name": cty.StringVal("test"),
+			},
+			expected: []string{},
+		},
+		"invalid compute_platform": {
+			config: map[string]cty.Value{
+				"compute_platform":       cty.StringVal("Foo"),
+				"deployment_config_name": cty.StringVal("test"),
+			},
+			expected: []string{
+				`expected compute_platform to be one of ["Server" "Lambda" "ECS"], got Foo`,
+			},
+		},
+		"conflicting arguments": {
+			config: map[string]cty.Value{
+				"deployment_config_name": cty.StringVal("test"),
+				"minimum_healthy_hosts": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					names.AttrType:  cty.StringVal("HOST_COUNT"),
+					names.AttrValue: cty.NumberIntVal(1),
+					"value_string":  cty.NullVal(cty.String),
+				})}),
+				"minimum_healthy_hosts_type":  cty.StringVal("HOST_COUNT"),
+				"minimum_healthy_hosts_value": cty.NumberIntVal(1),
+			},
+			expected: []string{
+				`Conflicting configuration arguments: "minimum_healthy_hosts": conflicts with minimum_healthy_hosts_type`,
+				`Conflicting configuration arguments: "minimum_healthy_hosts_type": conflicts with minimum_healthy_hosts`,
+				`Conflicting configuration arguments: "minimum_healthy_hosts_value": conflicts with minimum_healthy_hosts`,
+			},
+		},
+		"nested argument out of range": {
+			config: map[string]cty.Value{
+				"deployment_config_name": cty.StringVal("test"),
+				"traffic_routing_config": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"time_based_canary": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						names.AttrInterval: cty.NumberIntVal(5000),
+						"percentage":       cty.NumberIntVal(10),
+					})}),
+					"time_based_linear": cty.ListValEmpty(intervalPercentageType),
+					names.AttrType:      cty.StringVal("TimeBasedCanary"),
+				})}),
+			},
+			expected: []string{
+				"expected traffic_routing_config.0.time_based_canary.0.interval to be in the range (1 - 2880), got 5000",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcodedeploy.DeploymentConfigSchemaValidationErrors(cty.ObjectVal(testCase.config))
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccDeployDeploymentConfigValidationDataSource_valid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_codedeploy_deployment_config_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigValidationDataSourceConfig_valid(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "compute_platform", "Server"),
+					resource.TestCheckResourceAttr(dataSourceName, "deployment_config_name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "valid", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfigValidationDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_codedeploy_deployment_config_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigValidationDataSourceConfig_invalid(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "compute_platform", "ECS"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "errors.0", "zonal_config is not supported for the ECS compute platform; remove zonal_config or use the Server compute platform"),
+					resource.TestCheckResourceAttr(dataSourceName, "valid", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentConfigValidationDataSource_invalidSchema(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_codedeploy_deployment_config_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfigValidationDataSourceConfig_invalidSchema(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "errors.#", "2"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "errors.*", `expected compute_platform to be one of ["Server" "Lambda" "ECS"], got Foo`),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "errors.*", "expected traffic_routing_config.0.time_based_canary.0.interval to be in the range (1 - 2880), got 5000"),
+					resource.TestCheckResourceAttr(dataSourceName, "valid", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentConfigValidationDataSourceConfig_valid(rName string) string {
+	return fmt.Sprintf(`
+data "aws_codedeploy_deployment_config_validation" "test" {
+  deployment_config_name = %[1]q
+
+  minimum_healthy_hosts {
+    type  = "FLEET_PERCENT"
+    value = 75
+  }
+}
+`, rName)
+}
+
+func testAccDeploymentConfigValidationDataSourceConfig_invalid(rName string) string {
+	return fmt.Sprintf(`
+data "aws_codedeploy_deployment_config_validation" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = "ECS"
+
+  zonal_config {
+    monitor_duration_in_seconds = 10
+  }
+}
+`, rName)
+}
+
+func testAccDeploymentConfigValidationDataSourceConfig_invalidSchema(rName string) string {
+	return fmt.Sprintf(`
+data "aws_codedeploy_deployment_config_validation" "test" {
+  deployment_config_name = %[1]q
+  compute_platform       = "Foo"
+
+  traffic_routing_config {
+    type = "TimeBasedCanary"
+
+    time_based_canary {
+      interval   = 5000
+      percentage = 10
+    }
+  }
+}
+`, rName)
+}
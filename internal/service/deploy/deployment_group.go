@@ -14,7 +14,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
 	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -30,6 +32,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	// Newly created deployment groups can briefly be invisible to GetDeploymentGroup.
+	deploymentGroupPropagationTimeout = 2 * time.Minute
+)
+
 // @SDKResource("aws_codedeploy_deployment_group", name="Deployment Group")
 // @Tags(identifierAttribute="arn")
 func resourceDeploymentGroup() *schema.Resource {
@@ -59,6 +66,7 @@ func resourceDeploymentGroup() *schema.Resource {
 
 				d.SetId(aws.ToString(group.DeploymentGroupId))
 				d.Set("app_name", applicationName)
+				d.Set("check_name_availability", false)
 				d.Set("deployment_group_name", deploymentGroupName)
 
 				return []*schema.ResourceData{d}, nil
@@ -75,6 +83,8 @@ func resourceDeploymentGroup() *schema.Resource {
 						"alarms": {
 							Type:     schema.TypeSet,
 							Optional: true,
+							// CodeDeploy allows at most 10 CloudWatch alarms per deployment group.
+							MaxItems: 10,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						names.AttrEnabled: {
@@ -187,11 +197,20 @@ func resourceDeploymentGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"check_name_availability": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"deployment_config_name": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "CodeDeployDefault.OneAtATime",
-				ValidateFunc: validation.StringLenBetween(0, 100),
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "CodeDeployDefault.OneAtATime",
+				ValidateFunc: validation.Any(
+					validation.StringLenBetween(0, 100),
+					verify.ValidARN,
+				),
+				DiffSuppressFunc: suppressEquivalentDeploymentConfigName,
 			},
 			"deployment_group_id": {
 				Type:     schema.TypeString,
@@ -293,6 +312,30 @@ func resourceDeploymentGroup() *schema.Resource {
 					},
 				},
 			},
+			"last_attempted_deployment": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCreateTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deployment_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"load_balancer_info": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -411,6 +454,7 @@ func resourceDeploymentGroup() *schema.Resource {
 				Optional:         true,
 				Default:          types.OutdatedInstancesStrategyUpdate,
 				ValidateDiagFunc: enum.Validate[types.OutdatedInstancesStrategy](),
+				DiffSuppressFunc: suppressOmittedOutdatedInstancesStrategy,
 			},
 			names.AttrServiceRoleARN: {
 				Type:         schema.TypeString,
@@ -451,8 +495,167 @@ func resourceDeploymentGroup() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceDeploymentGroupCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceDeploymentGroupCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// blue_green_deployment_config is Computed, so only validate the configured provisioning action.
+	if action := configuredGreenFleetProvisioningAction(diff.GetRawConfig()); action == types.GreenFleetProvisioningActionCopyAutoScalingGroup {
+		if diff.NewValueKnown("autoscaling_groups") && diff.Get("autoscaling_groups").(*schema.Set).Len() == 0 {
+			return fmt.Errorf("blue_green_deployment_config.0.green_fleet_provisioning_option.0.action %q requires at least one autoscaling_groups entry", action)
+		}
+	}
+
+	if err := resourceDeploymentGroupCustomizeDiffComputePlatform(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	// compute_platform is only known once the application exists.
+	if diff.NewValueKnown("compute_platform") && diff.NewValueKnown("deployment_style") {
+		if v := diff.GetRawConfig().GetAttr("load_balancer_info"); v.IsKnown() {
+			var deploymentOption types.DeploymentOption
+			if v, ok := diff.Get("deployment_style").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				deploymentOption = types.DeploymentOption(v[0].(map[string]interface{})["deployment_option"].(string))
+			}
+
+			if err := validateDeploymentGroupLoadBalancerInfo(types.ComputePlatform(diff.Get("compute_platform").(string)), deploymentOption, !v.IsNull() && v.LengthInt() > 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Looking up the name costs an API call per plan, so only do it when asked to.
+	if diff.Get("check_name_availability").(bool) && (diff.Id() == "" || diff.HasChange("deployment_group_name")) {
+		if diff.NewValueKnown("app_name") && diff.NewValueKnown("deployment_group_name") {
+			conn := meta.(*conns.AWSClient).DeployClient(ctx)
+
+			if err := checkDeploymentGroupNameAvailable(ctx, conn, diff.Get("app_name").(string), diff.Get("deployment_group_name").(string)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceDeploymentGroupCustomizeDiffComputePlatform validates the deployment group against its application's compute platform.
+// The compute platform recorded in state is reused unless the deployment group is new or moves to another application.
+func resourceDeploymentGroupCustomizeDiffComputePlatform(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("app_name") || !diff.NewValueKnown("autoscaling_groups") {
+		return nil
+	}
+
+	ecsService := diff.GetRawConfig().GetAttr("ecs_service")
+	if !ecsService.IsKnown() {
+		return nil
+	}
+	hasECSService := !ecsService.IsNull() && ecsService.LengthInt() > 0
+	hasAutoScalingGroups := diff.Get("autoscaling_groups").(*schema.Set).Len() > 0
+
+	if diff.Id() != "" && !diff.HasChange("app_name") {
+		return validateDeploymentGroupForComputePlatform(types.ComputePlatform(diff.Get("compute_platform").(string)), hasECSService, hasAutoScalingGroups)
+	}
+
+	conn := meta.(*conns.AWSClient).DeployClient(ctx)
+	applicationName := diff.Get("app_name").(string)
+	computePlatform, err := findApplicationComputePlatform(ctx, conn, applicationName)
+
+	// The application is created in the same apply, so there is nothing to validate against yet.
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading CodeDeploy Application (%s): %w", applicationName, err)
+	}
+
+	if err := validateDeploymentGroupForComputePlatform(computePlatform, hasECSService, hasAutoScalingGroups); err != nil {
+		return fmt.Errorf("CodeDeploy Application (%s): %w", applicationName, err)
+	}
+
+	return diff.SetNew("compute_platform", computePlatform)
+}
+
+// validateDeploymentGroupForComputePlatform returns an error if the deployment group's targets don't suit the compute platform.
+// Unrecognized (or not yet known) compute platforms are not validated.
+func validateDeploymentGroupForComputePlatform(computePlatform types.ComputePlatform, hasECSService, hasAutoScalingGroups bool) error {
+	switch computePlatform {
+	case types.ComputePlatformEcs:
+		if !hasECSService {
+			return fmt.Errorf("ecs_service is required for the %s compute platform", computePlatform)
+		}
+		if hasAutoScalingGroups {
+			return fmt.Errorf("autoscaling_groups is not supported for the %s compute platform", computePlatform)
+		}
+	case types.ComputePlatformLambda:
+		if hasECSService {
+			return fmt.Errorf("ecs_service is not supported for the %s compute platform", computePlatform)
+		}
+		if hasAutoScalingGroups {
+			return fmt.Errorf("autoscaling_groups is not supported for the %s compute platform", computePlatform)
+		}
+	case types.ComputePlatformServer:
+		if hasECSService {
+			return fmt.Errorf("ecs_service is not supported for the %s compute platform", computePlatform)
+		}
+	}
+
+	return nil
+}
+
+// validateDeploymentGroupLoadBalancerInfo returns an error if traffic control is requested without a load balancer to control.
+// Lambda deployments shift traffic between function versions, so never need one.
+func validateDeploymentGroupLoadBalancerInfo(computePlatform types.ComputePlatform, deploymentOption types.DeploymentOption, hasLoadBalancerInfo bool) error {
+	if computePlatform == "" || computePlatform == types.ComputePlatformLambda {
+		return nil
+	}
+
+	if deploymentOption == types.DeploymentOptionWithTrafficControl && !hasLoadBalancerInfo {
+		return fmt.Errorf("load_balancer_info is required when deployment_style.0.deployment_option is %s; add a load_balancer_info block, or set deployment_option to %s to deploy without routing traffic through a load balancer", deploymentOption, types.DeploymentOptionWithoutTrafficControl)
+	}
+
+	return nil
+}
+
+func checkDeploymentGroupNameAvailable(ctx context.Context, conn *codedeploy.Client, applicationName, deploymentGroupName string) error {
+	_, err := findDeploymentGroupByTwoPartKey(ctx, conn, applicationName, deploymentGroupName)
+
+	if tfresource.NotFound(err) {
+		return nil
 	}
+
+	if err != nil {
+		return fmt.Errorf("reading CodeDeploy Deployment Group (%s,%s): %w", applicationName, deploymentGroupName, err)
+	}
+
+	return fmt.Errorf("CodeDeploy Deployment Group (%s) already exists in application (%s)", deploymentGroupName, applicationName)
+}
+
+func configuredGreenFleetProvisioningAction(config cty.Value) types.GreenFleetProvisioningAction {
+	v := config
+	for _, name := range []string{"blue_green_deployment_config", "green_fleet_provisioning_option"} {
+		if !v.IsKnown() || v.IsNull() {
+			return ""
+		}
+
+		v = v.GetAttr(name)
+
+		if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+			return ""
+		}
+
+		v = v.Index(cty.NumberIntVal(0))
+	}
+
+	if v = v.GetAttr(names.AttrAction); !v.IsKnown() || v.IsNull() {
+		return ""
+	}
+
+	return types.GreenFleetProvisioningAction(v.AsString())
 }
 
 func resourceDeploymentGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -490,7 +693,11 @@ func resourceDeploymentGroupCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	if v, ok := d.GetOk("deployment_config_name"); ok {
-		input.DeploymentConfigName = aws.String(v.(string))
+		name, err := deploymentConfigNameFromNameOrARN(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		input.DeploymentConfigName = aws.String(name)
 	}
 
 	if v, ok := d.GetOk("ec2_tag_set"); ok {
@@ -555,7 +762,7 @@ func resourceDeploymentGroupRead(ctx context.Context, d *schema.ResourceData, me
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DeployClient(ctx)
 
-	group, err := findDeploymentGroupByTwoPartKey(ctx, conn, d.Get("app_name").(string), d.Get("deployment_group_name").(string))
+	group, err := findDeploymentGroupByTwoPartKeyWhenNew(ctx, conn, d.Get("app_name").(string), d.Get("deployment_group_name").(string), d.IsNewResource(), deploymentGroupPropagationTimeout)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] CodeDeploy Deployment Group (%s) not found, removing from state", d.Id())
@@ -606,6 +813,9 @@ func resourceDeploymentGroupRead(ctx context.Context, d *schema.ResourceData, me
 	if err := d.Set("ecs_service", flattenECSServices(group.EcsServices)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting ecs_service: %s", err)
 	}
+	if err := d.Set("last_attempted_deployment", flattenLastDeploymentInfo(group.LastAttemptedDeployment)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting last_attempted_deployment: %s", err)
+	}
 	if err := d.Set("load_balancer_info", flattenLoadBalancerInfo(group.LoadBalancerInfo)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting load_balancer_info: %s", err)
 	}
@@ -647,7 +857,11 @@ func resourceDeploymentGroupUpdate(ctx context.Context, d *schema.ResourceData,
 
 		if d.HasChange("deployment_config_name") {
 			_, n := d.GetChange("deployment_config_name")
-			input.DeploymentConfigName = aws.String(n.(string))
+			name, err := deploymentConfigNameFromNameOrARN(n.(string))
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+			input.DeploymentConfigName = aws.String(name)
 		}
 
 		// include (original or new) autoscaling groups when blue_green_deployment_config changes except for ECS
@@ -679,21 +893,11 @@ func resourceDeploymentGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			input.EcsServices = expandECSServices(d.Get("ecs_service").([]interface{}))
 		}
 
-		if d.HasChange("trigger_configuration") {
-			_, n := d.GetChange("trigger_configuration")
-			triggerConfigs := expandTriggerConfigs(n.(*schema.Set).List())
-			input.TriggerConfigurations = triggerConfigs
-		}
-
-		if d.HasChange("auto_rollback_configuration") {
-			_, n := d.GetChange("auto_rollback_configuration")
-			input.AutoRollbackConfiguration = expandAutoRollbackConfiguration(n.([]interface{}))
-		}
-
-		if d.HasChange("alarm_configuration") {
-			_, n := d.GetChange("alarm_configuration")
-			input.AlarmConfiguration = expandAlarmConfiguration(n.([]interface{}))
-		}
+		// Always send the desired triggers, alarms and auto-rollback settings, even when unchanged,
+		// so that an update to any other argument can never clear them.
+		input.TriggerConfigurations = expandTriggerConfigs(d.Get("trigger_configuration").(*schema.Set).List())
+		input.AutoRollbackConfiguration = expandAutoRollbackConfiguration(d.Get("auto_rollback_configuration").([]interface{}))
+		input.AlarmConfiguration = expandAlarmConfiguration(d.Get("alarm_configuration").([]interface{}))
 
 		if d.HasChange("load_balancer_info") {
 			_, n := d.GetChange("load_balancer_info")
@@ -765,6 +969,20 @@ func resourceDeploymentGroupDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+// findDeploymentGroupByTwoPartKeyWhenNew is findDeploymentGroupByTwoPartKey, retrying NotFound errors for up to timeout
+// when the deployment group has just been created.
+func findDeploymentGroupByTwoPartKeyWhenNew(ctx context.Context, conn *codedeploy.Client, applicationName, deploymentGroupName string, isNewResource bool, timeout time.Duration) (*types.DeploymentGroupInfo, error) {
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, timeout, func() (interface{}, error) {
+		return findDeploymentGroupByTwoPartKey(ctx, conn, applicationName, deploymentGroupName)
+	}, isNewResource)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*types.DeploymentGroupInfo), nil
+}
+
 func findDeploymentGroupByTwoPartKey(ctx context.Context, conn *codedeploy.Client, applicationName, deploymentGroupName string) (*types.DeploymentGroupInfo, error) {
 	input := &codedeploy.GetDeploymentGroupInput{
 		ApplicationName:     aws.String(applicationName),
@@ -791,6 +1009,20 @@ func findDeploymentGroupByTwoPartKey(ctx context.Context, conn *codedeploy.Clien
 	return output.DeploymentGroupInfo, nil
 }
 
+// suppressEquivalentDeploymentConfigName suppresses the diff between a deployment config ARN in configuration
+// and the deployment config name CodeDeploy returns.
+func suppressEquivalentDeploymentConfigName(k, old, new string, d *schema.ResourceData) bool {
+	name, err := deploymentConfigNameFromNameOrARN(new)
+
+	return err == nil && name == old
+}
+
+// suppressOmittedOutdatedInstancesStrategy suppresses the diff against the default strategy when CodeDeploy
+// omits outdatedInstancesStrategy for an existing deployment group, e.g. one last modified in the console.
+func suppressOmittedOutdatedInstancesStrategy(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == "" && new == string(types.OutdatedInstancesStrategyUpdate)
+}
+
 func expandTagFilters(configured []interface{}) []types.TagFilter {
 	filters := make([]types.TagFilter, 0)
 	for _, raw := range configured {
@@ -1289,6 +1521,26 @@ func flattenDeploymentStyle(style *types.DeploymentStyle) []map[string]interface
 	return result
 }
 
+func flattenLastDeploymentInfo(apiObject *types.LastDeploymentInfo) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"deployment_id":  aws.ToString(apiObject.DeploymentId),
+		names.AttrStatus: apiObject.Status,
+	}
+
+	if v := apiObject.CreateTime; v != nil {
+		tfMap[names.AttrCreateTime] = aws.ToTime(v).Format(time.RFC3339)
+	}
+	if v := apiObject.EndTime; v != nil {
+		tfMap["end_time"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
+
 func flattenLoadBalancerInfo(loadBalancerInfo *types.LoadBalancerInfo) []interface{} {
 	if loadBalancerInfo == nil {
 		return []interface{}{}
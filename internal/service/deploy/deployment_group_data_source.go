@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deploy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_codedeploy_deployment_group", name="Deployment Group")
+// @Tags(identifierAttribute="arn")
+func dataSourceDeploymentGroup() *schema.Resource {
+	tagFilterSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeSet,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrKey: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					names.AttrType: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					names.AttrValue: {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDeploymentGroupRead,
+
+		Schema: map[string]*schema.Schema{
+			"app_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"autoscaling_groups": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"compute_platform": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_minimum_healthy_hosts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"deployment_config_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_group_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"deployment_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"ec2_tag_filter": tagFilterSchema(),
+			"ec2_tag_set": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ec2_tag_filter": tagFilterSchema(),
+					},
+				},
+			},
+			"on_premises_instance_tag_filter": tagFilterSchema(),
+			"outdated_instances_strategy": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrServiceRoleARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			"termination_hook_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDeploymentGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DeployClient(ctx)
+
+	appName := d.Get("app_name").(string)
+	groupName := d.Get("deployment_group_name").(string)
+	group, err := findDeploymentGroupByTwoPartKey(ctx, conn, appName, groupName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeDeploy Deployment Group (%s:%s): %s", appName, groupName, err)
+	}
+
+	d.SetId(aws.ToString(group.DeploymentGroupId))
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition(ctx),
+		Service:   "codedeploy",
+		Region:    meta.(*conns.AWSClient).Region(ctx),
+		AccountID: meta.(*conns.AWSClient).AccountID(ctx),
+		Resource:  fmt.Sprintf("deploymentgroup:%s/%s", appName, groupName),
+	}.String()
+	d.Set(names.AttrARN, arn)
+	d.Set("autoscaling_groups", tfslices.ApplyToAll(group.AutoScalingGroups, func(v types.AutoScalingGroup) string {
+		return aws.ToString(v.Name)
+	}))
+	d.Set("compute_platform", group.ComputePlatform)
+	if err := d.Set("default_minimum_healthy_hosts", flattenMinimumHealthHosts(defaultMinimumHealthyHosts(group.ComputePlatform))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting default_minimum_healthy_hosts: %s", err)
+	}
+	d.Set("deployment_config_name", group.DeploymentConfigName)
+	d.Set("deployment_group_id", group.DeploymentGroupId)
+	if err := d.Set("ec2_tag_filter", flattenEC2TagFilters(group.Ec2TagFilters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ec2_tag_filter: %s", err)
+	}
+	if err := d.Set("ec2_tag_set", flattenEC2TagSet(group.Ec2TagSet)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ec2_tag_set: %s", err)
+	}
+	if err := d.Set("on_premises_instance_tag_filter", flattenTagFilters(group.OnPremisesInstanceTagFilters)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting on_premises_instance_tag_filter: %s", err)
+	}
+	d.Set("outdated_instances_strategy", group.OutdatedInstancesStrategy)
+	d.Set(names.AttrServiceRoleARN, group.ServiceRoleArn)
+	d.Set("termination_hook_enabled", group.TerminationHookEnabled)
+
+	return diags
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deploy_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDeployDeploymentGroupDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_codedeploy_deployment_group.test"
+	resourceName := "aws_codedeploy_deployment_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentGroupDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "app_name", resourceName, "app_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "compute_platform", resourceName, "compute_platform"),
+					resource.TestCheckResourceAttr(dataSourceName, "default_minimum_healthy_hosts.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "default_minimum_healthy_hosts.0.type", "FLEET_PERCENT"),
+					resource.TestCheckResourceAttr(dataSourceName, "default_minimum_healthy_hosts.0.value", "99"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployment_config_name", resourceName, "deployment_config_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployment_group_id", resourceName, "deployment_group_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "deployment_group_name", resourceName, "deployment_group_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "outdated_instances_strategy", resourceName, "outdated_instances_strategy"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrServiceRoleARN, resourceName, names.AttrServiceRoleARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, "termination_hook_enabled", resourceName, "termination_hook_enabled"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentGroupDataSource_tagFilters(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_codedeploy_deployment_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentGroupDataSourceConfig_tagFilters(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ec2_tag_filter.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "ec2_tag_set.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "ec2_tag_set.*.ec2_tag_filter.*", map[string]string{
+						names.AttrKey:   "filterkey1",
+						names.AttrType:  "KEY_AND_VALUE",
+						names.AttrValue: "filtervalue",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "ec2_tag_set.*.ec2_tag_filter.*", map[string]string{
+						names.AttrKey:  "filterkey2",
+						names.AttrType: "KEY_ONLY",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "ec2_tag_set.*.ec2_tag_filter.*", map[string]string{
+						names.AttrType:  "VALUE_ONLY",
+						names.AttrValue: "filtervalue",
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "on_premises_instance_tag_filter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "on_premises_instance_tag_filter.*", map[string]string{
+						names.AttrKey:   "filterkey1",
+						names.AttrType:  "KEY_AND_VALUE",
+						names.AttrValue: "filtervalue",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "on_premises_instance_tag_filter.*", map[string]string{
+						names.AttrKey:  "filterkey2",
+						names.AttrType: "KEY_ONLY",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeploymentGroupDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_basic(rName, false), `
+data "aws_codedeploy_deployment_group" "test" {
+  app_name              = aws_codedeploy_deployment_group.test.app_name
+  deployment_group_name = aws_codedeploy_deployment_group.test.deployment_group_name
+}
+`)
+}
+
+func testAccDeploymentGroupDataSourceConfig_tagFilters(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name              = aws_codedeploy_app.test.name
+  deployment_group_name = %[1]q
+  service_role_arn      = aws_iam_role.test.arn
+
+  ec2_tag_set {
+    ec2_tag_filter {
+      key   = "filterkey1"
+      type  = "KEY_AND_VALUE"
+      value = "filtervalue"
+    }
+
+    ec2_tag_filter {
+      key  = "filterkey2"
+      type = "KEY_ONLY"
+    }
+  }
+
+  ec2_tag_set {
+    ec2_tag_filter {
+      type  = "VALUE_ONLY"
+      value = "filtervalue"
+    }
+  }
+
+  on_premises_instance_tag_filter {
+    key   = "filterkey1"
+    type  = "KEY_AND_VALUE"
+    value = "filtervalue"
+  }
+
+  on_premises_instance_tag_filter {
+    key  = "filterkey2"
+    type = "KEY_ONLY"
+  }
+}
+
+data "aws_codedeploy_deployment_group" "test" {
+  app_name              = aws_codedeploy_deployment_group.test.app_name
+  deployment_group_name = aws_codedeploy_deployment_group.test.deployment_group_name
+}
+`, rName))
+}
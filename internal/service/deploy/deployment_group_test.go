@@ -5,16 +5,27 @@ package deploy_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"regexp"
 	"slices"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
 	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -23,6 +34,547 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestFindDeploymentGroupByTwoPartKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	conn := testDeploymentGroupStubClient()
+
+	testCases := map[string]struct {
+		applicationName     string
+		deploymentGroupName string
+		expectNotFound      bool
+		expectError         bool
+	}{
+		"found": {
+			applicationName:     "app",
+			deploymentGroupName: "group",
+		},
+		"application not found": {
+			applicationName:     "missing-app",
+			deploymentGroupName: "group",
+			expectNotFound:      true,
+		},
+		"deployment group not found": {
+			applicationName:     "app",
+			deploymentGroupName: "missing-group",
+			expectNotFound:      true,
+		},
+		"other error": {
+			applicationName:     "error-app",
+			deploymentGroupName: "group",
+			expectError:         true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := tfcodedeploy.FindDeploymentGroupByTwoPartKey(ctx, conn, testCase.applicationName, testCase.deploymentGroupName)
+
+			if got := tfresource.NotFound(err); got != testCase.expectNotFound {
+				t.Errorf("NotFound = %t, want %t (err: %v)", got, testCase.expectNotFound, err)
+			}
+
+			if testCase.expectNotFound || testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := aws.ToString(output.DeploymentGroupName); got != testCase.deploymentGroupName {
+				t.Errorf("DeploymentGroupName = %q, want %q", got, testCase.deploymentGroupName)
+			}
+		})
+	}
+}
+
+func TestCheckDeploymentGroupNameAvailable(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	conn := testDeploymentGroupStubClient()
+
+	testCases := map[string]struct {
+		applicationName     string
+		deploymentGroupName string
+		expectedError       *regexp.Regexp
+	}{
+		"name taken": {
+			applicationName:     "app",
+			deploymentGroupName: "group",
+			expectedError:       regexache.MustCompile(`CodeDeploy Deployment Group \(group\) already exists in application \(app\)`),
+		},
+		"name available": {
+			applicationName:     "app",
+			deploymentGroupName: "missing-group",
+		},
+		"application not found": {
+			applicationName:     "missing-app",
+			deploymentGroupName: "group",
+		},
+		"lookup error": {
+			applicationName:     "error-app",
+			deploymentGroupName: "group",
+			expectedError:       regexache.MustCompile(`reading CodeDeploy Deployment Group \(error-app,group\)`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfcodedeploy.CheckDeploymentGroupNameAvailable(ctx, conn, testCase.applicationName, testCase.deploymentGroupName)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("error %q does not match %q", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func testDeploymentGroupStubClient() *codedeploy.Client {
+	return codedeploy.New(codedeploy.Options{
+		Region: "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{
+			func(stack *middleware.Stack) error {
+				return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("stubGetDeploymentGroup",
+					func(_ context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+						input := in.Parameters.(*codedeploy.GetDeploymentGroupInput)
+
+						switch aws.ToString(input.ApplicationName) {
+						case "missing-app":
+							return middleware.InitializeOutput{}, middleware.Metadata{}, &types.ApplicationDoesNotExistException{Message: aws.String("missing")}
+						case "error-app":
+							return middleware.InitializeOutput{}, middleware.Metadata{}, &types.InvalidApplicationNameException{Message: aws.String("invalid")}
+						}
+
+						if aws.ToString(input.DeploymentGroupName) == "missing-group" {
+							return middleware.InitializeOutput{}, middleware.Metadata{}, &types.DeploymentGroupDoesNotExistException{Message: aws.String("missing")}
+						}
+
+						output := &codedeploy.GetDeploymentGroupOutput{
+							DeploymentGroupInfo: &types.DeploymentGroupInfo{
+								ApplicationName:     input.ApplicationName,
+								DeploymentGroupName: input.DeploymentGroupName,
+							},
+						}
+
+						return middleware.InitializeOutput{Result: output}, middleware.Metadata{}, nil
+					}), middleware.Before)
+			},
+		},
+	})
+}
+
+func TestFindDeploymentGroupByTwoPartKeyWhenNew(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		isNewResource bool
+		notFoundCalls int
+		expectedCalls int
+		errorExpected bool
+	}{
+		"new resource visible after delay": {
+			isNewResource: true,
+			notFoundCalls: 2,
+			expectedCalls: 3,
+		},
+		"new resource never visible": {
+			isNewResource: true,
+			notFoundCalls: math.MaxInt,
+			errorExpected: true,
+		},
+		"existing resource not found": {
+			notFoundCalls: 1,
+			expectedCalls: 1,
+			errorExpected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			conn := testDeploymentGroupDelayedStubClient(testCase.notFoundCalls, &calls)
+
+			group, err := tfcodedeploy.FindDeploymentGroupByTwoPartKeyWhenNew(ctx, conn, "app", "group", testCase.isNewResource, 5*time.Second)
+
+			if err != nil && !testCase.errorExpected {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.errorExpected {
+				t.Fatal("expected error, got none")
+			}
+
+			if err == nil && aws.ToString(group.DeploymentGroupName) != "group" {
+				t.Errorf("got deployment group %q, want %q", aws.ToString(group.DeploymentGroupName), "group")
+			}
+
+			if testCase.expectedCalls > 0 && calls != testCase.expectedCalls {
+				t.Errorf("GetDeploymentGroup calls = %d, want %d", calls, testCase.expectedCalls)
+			}
+		})
+	}
+}
+
+// testDeploymentGroupDelayedStubClient returns a client whose first notFoundCalls GetDeploymentGroup calls fail with
+// DeploymentGroupDoesNotExistException, simulating a newly created deployment group that isn't yet visible.
+func testDeploymentGroupDelayedStubClient(notFoundCalls int, calls *int) *codedeploy.Client {
+	var mu sync.Mutex
+
+	return codedeploy.New(codedeploy.Options{
+		Region: "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{
+			func(stack *middleware.Stack) error {
+				return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("stubGetDeploymentGroup",
+					func(_ context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+						input := in.Parameters.(*codedeploy.GetDeploymentGroupInput)
+
+						mu.Lock()
+						*calls++
+						n := *calls
+						mu.Unlock()
+
+						if n <= notFoundCalls {
+							return middleware.InitializeOutput{}, middleware.Metadata{}, &types.DeploymentGroupDoesNotExistException{Message: aws.String("missing")}
+						}
+
+						output := &codedeploy.GetDeploymentGroupOutput{
+							DeploymentGroupInfo: &types.DeploymentGroupInfo{
+								ApplicationName:     input.ApplicationName,
+								DeploymentGroupName: input.DeploymentGroupName,
+							},
+						}
+
+						return middleware.InitializeOutput{Result: output}, middleware.Metadata{}, nil
+					}), middleware.Before)
+			},
+		},
+	})
+}
+
+func TestValidateDeploymentGroupForComputePlatform(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	conn := testApplicationStubClient()
+
+	testCases := map[string]struct {
+		applicationName      string
+		hasECSService        bool
+		hasAutoScalingGroups bool
+		expectedError        *regexp.Regexp
+	}{
+		"ECS with ecs_service": {
+			applicationName: "ecs-app",
+			hasECSService:   true,
+		},
+		"ECS without ecs_service": {
+			applicationName: "ecs-app",
+			expectedError:   regexache.MustCompile(`ecs_service is required for the ECS compute platform`),
+		},
+		"ECS with autoscaling_groups": {
+			applicationName:      "ecs-app",
+			hasECSService:        true,
+			hasAutoScalingGroups: true,
+			expectedError:        regexache.MustCompile(`autoscaling_groups is not supported for the ECS compute platform`),
+		},
+		"Lambda": {
+			applicationName: "lambda-app",
+		},
+		"Lambda with ecs_service": {
+			applicationName: "lambda-app",
+			hasECSService:   true,
+			expectedError:   regexache.MustCompile(`ecs_service is not supported for the Lambda compute platform`),
+		},
+		"Server with autoscaling_groups": {
+			applicationName:      "server-app",
+			hasAutoScalingGroups: true,
+		},
+		"Server with ecs_service": {
+			applicationName: "server-app",
+			hasECSService:   true,
+			expectedError:   regexache.MustCompile(`ecs_service is not supported for the Server compute platform`),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			computePlatform, err := tfcodedeploy.FindApplicationComputePlatform(ctx, conn, testCase.applicationName)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			err = tfcodedeploy.ValidateDeploymentGroupForComputePlatform(computePlatform, testCase.hasECSService, testCase.hasAutoScalingGroups)
+
+			if testCase.expectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("error %q does not match %q", err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestValidateDeploymentGroupLoadBalancerInfo(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		computePlatform     types.ComputePlatform
+		deploymentOption    types.DeploymentOption
+		hasLoadBalancerInfo bool
+		errorExpected       bool
+	}{
+		"with traffic control and load balancer": {
+			computePlatform:     types.ComputePlatformServer,
+			deploymentOption:    types.DeploymentOptionWithTrafficControl,
+			hasLoadBalancerInfo: true,
+		},
+		"with traffic control without load balancer": {
+			computePlatform:  types.ComputePlatformServer,
+			deploymentOption: types.DeploymentOptionWithTrafficControl,
+			errorExpected:    true,
+		},
+		"without traffic control and load balancer": {
+			computePlatform:     types.ComputePlatformServer,
+			deploymentOption:    types.DeploymentOptionWithoutTrafficControl,
+			hasLoadBalancerInfo: true,
+		},
+		"without traffic control without load balancer": {
+			computePlatform:  types.ComputePlatformServer,
+			deploymentOption: types.DeploymentOptionWithoutTrafficControl,
+		},
+		"ECS with traffic control without load balancer": {
+			computePlatform:  types.ComputePlatformEcs,
+			deploymentOption: types.DeploymentOptionWithTrafficControl,
+			errorExpected:    true,
+		},
+		"Lambda with traffic control": {
+			computePlatform:  types.ComputePlatformLambda,
+			deploymentOption: types.DeploymentOptionWithTrafficControl,
+		},
+		"unknown compute platform": {
+			deploymentOption: types.DeploymentOptionWithTrafficControl,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfcodedeploy.ValidateDeploymentGroupLoadBalancerInfo(testCase.computePlatform, testCase.deploymentOption, testCase.hasLoadBalancerInfo)
+
+			if err != nil && !testCase.errorExpected {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.errorExpected {
+				t.Fatal("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestSuppressOmittedOutdatedInstancesStrategy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id       string
+		old      string
+		new      string
+		suppress bool
+	}{
+		"omitted by API": {
+			id:       "123",
+			new:      string(types.OutdatedInstancesStrategyUpdate),
+			suppress: true,
+		},
+		"omitted by API with ignore configured": {
+			id:  "123",
+			new: string(types.OutdatedInstancesStrategyIgnore),
+		},
+		"changed": {
+			id:  "123",
+			old: string(types.OutdatedInstancesStrategyIgnore),
+			new: string(types.OutdatedInstancesStrategyUpdate),
+		},
+		"new resource": {
+			new: string(types.OutdatedInstancesStrategyUpdate),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := tfcodedeploy.ResourceDeploymentGroup().TestResourceData()
+			d.SetId(testCase.id)
+
+			if got := tfcodedeploy.SuppressOmittedOutdatedInstancesStrategy("outdated_instances_strategy", testCase.old, testCase.new, d); got != testCase.suppress {
+				t.Errorf("suppress = %t, want %t", got, testCase.suppress)
+			}
+		})
+	}
+}
+
+// testApplicationStubClient returns a client whose GetApplication calls are answered locally.
+// Applications are named after their compute platform, e.g. "ecs-app"; "missing-app" does not exist.
+func testApplicationStubClient() *codedeploy.Client {
+	return codedeploy.New(codedeploy.Options{
+		Region: "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{
+			func(stack *middleware.Stack) error {
+				return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("stubGetApplication",
+					func(_ context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+						name := aws.ToString(in.Parameters.(*codedeploy.GetApplicationInput).ApplicationName)
+
+						var computePlatform types.ComputePlatform
+						switch name {
+						case "ecs-app":
+							computePlatform = types.ComputePlatformEcs
+						case "lambda-app":
+							computePlatform = types.ComputePlatformLambda
+						case "server-app":
+							computePlatform = types.ComputePlatformServer
+						default:
+							return middleware.InitializeOutput{}, middleware.Metadata{}, &types.ApplicationDoesNotExistException{Message: aws.String("missing")}
+						}
+
+						output := &codedeploy.GetApplicationOutput{
+							Application: &types.ApplicationInfo{
+								ApplicationName: aws.String(name),
+								ComputePlatform: computePlatform,
+							},
+						}
+
+						return middleware.InitializeOutput{Result: output}, middleware.Metadata{}, nil
+					}), middleware.Before)
+			},
+		},
+	})
+}
+
+func TestFlattenTriggerConfigs_orderIndependent(t *testing.T) {
+	t.Parallel()
+
+	trigger1 := types.TriggerConfig{
+		TriggerEvents:    []types.TriggerEventType{types.TriggerEventTypeDeploymentFailure, types.TriggerEventTypeDeploymentSuccess},
+		TriggerName:      aws.String("trigger-1"),
+		TriggerTargetArn: aws.String("arn:aws:sns:us-west-2:123456789012:topic-1"), //lintignore:AWSAT003,AWSAT005
+	}
+	trigger2 := types.TriggerConfig{
+		TriggerEvents:    []types.TriggerEventType{types.TriggerEventTypeInstanceFailure},
+		TriggerName:      aws.String("trigger-2"),
+		TriggerTargetArn: aws.String("arn:aws:sns:us-west-2:123456789012:topic-2"), //lintignore:AWSAT003,AWSAT005
+	}
+	// The API can return both the triggers and each trigger's events in any order.
+	trigger1Reordered := trigger1
+	trigger1Reordered.TriggerEvents = []types.TriggerEventType{types.TriggerEventTypeDeploymentSuccess, types.TriggerEventTypeDeploymentFailure}
+
+	configured := tfcodedeploy.ResourceDeploymentGroup().Data(nil)
+	if err := configured.Set("trigger_configuration", tfcodedeploy.FlattenTriggerConfigs([]types.TriggerConfig{trigger1, trigger2})); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read := tfcodedeploy.ResourceDeploymentGroup().Data(nil)
+	if err := read.Set("trigger_configuration", tfcodedeploy.FlattenTriggerConfigs([]types.TriggerConfig{trigger2, trigger1Reordered})); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !configured.Get("trigger_configuration").(*schema.Set).Equal(read.Get("trigger_configuration").(*schema.Set)) {
+		t.Errorf("trigger_configuration differs when read in a different order:\n%#v\n%#v", configured.Get("trigger_configuration"), read.Get("trigger_configuration"))
+	}
+}
+
+func TestResourceDeploymentGroup_alarmConfigurationMaxAlarms(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		count         int
+		errorExpected bool
+	}{
+		"10 alarms": {
+			count: 10,
+		},
+		"11 alarms": {
+			count:         11,
+			errorExpected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			alarms := make([]interface{}, 0, testCase.count)
+			for i := range testCase.count {
+				alarms = append(alarms, fmt.Sprintf("alarm-%d", i))
+			}
+
+			config := sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+				"app_name":               "test",
+				"deployment_group_name":  "test",
+				names.AttrServiceRoleARN: "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+				"alarm_configuration": []interface{}{
+					map[string]interface{}{
+						"alarms":          alarms,
+						names.AttrEnabled: true,
+					},
+				},
+			})
+
+			diags := tfcodedeploy.ResourceDeploymentGroup().Validate(config)
+
+			if diags.HasError() && !testCase.errorExpected {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if !diags.HasError() && testCase.errorExpected {
+				t.Fatal("expected error, got none")
+			}
+
+			if testCase.errorExpected && !strings.Contains(fmt.Sprint(diags), "10 item maximum") {
+				t.Errorf("expected diagnostic to state the 10 alarm limit, got: %v", diags)
+			}
+		})
+	}
+}
+
 func TestAccDeployDeploymentGroup_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group types.DeploymentGroupInfo
@@ -92,6 +644,48 @@ func TestAccDeployDeploymentGroup_basic(t *testing.T) {
 	})
 }
 
+func TestAccDeployDeploymentGroup_serviceRoleARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group1, group2 types.DeploymentGroupInfo
+	resourceName := "aws_codedeploy_deployment_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentGroupConfig_serviceRoleARN(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group1),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrServiceRoleARN, "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccDeploymentGroupConfig_serviceRoleARN(rName, "test_updated"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group2),
+					testAccCheckDeploymentGroupNotRecreated(&group1, &group2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrServiceRoleARN, "aws_iam_role.test_updated", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccDeploymentGroupImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccDeployDeploymentGroup_Basic_tagSet(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group types.DeploymentGroupInfo
@@ -691,6 +1285,151 @@ func TestAccDeployDeploymentGroup_Alarm_disable(t *testing.T) {
 }
 
 // When no configuration is provided, a deploymentStyle object with default values is computed
+func TestAccDeployDeploymentGroup_rename_preservesTriggersAndAlarms(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group types.DeploymentGroupInfo
+	resourceName := "aws_codedeploy_deployment_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentGroupConfig_triggersAndAlarms(rName, rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "deployment_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "trigger_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.alarms.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback_configuration.0.enabled", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccDeploymentGroupConfig_triggersAndAlarms(rName, rNameUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "deployment_group_name", rNameUpdated),
+					testAccCheckDeploymentGroupTriggerEvents(&group, "test-trigger", []string{
+						"DeploymentFailure",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "trigger_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.alarms.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "alarm_configuration.0.alarms.*", "test-alarm"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_configuration.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rollback_configuration.0.enabled", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentGroup_checkNameAvailability(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group types.DeploymentGroupInfo
+	resourceName := "aws_codedeploy_deployment_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentGroupConfig_checkNameAvailability(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "check_name_availability", acctest.CtFalse),
+				),
+			},
+			{
+				Config:      testAccDeploymentGroupConfig_checkNameAvailability(rName, true),
+				ExpectError: regexache.MustCompile(`CodeDeploy Deployment Group \(` + rName + `\) already exists in application`),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentGroup_ECS_computePlatformValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			// The application must exist before the deployment group is planned for its compute platform to be known.
+			{
+				Config: testAccDeploymentGroupConfig_ecsApplication(rName),
+			},
+			{
+				Config:      testAccDeploymentGroupConfig_ecsWithoutECSService(rName),
+				ExpectError: regexache.MustCompile(`ecs_service is required for the ECS compute platform`),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentGroup_DeploymentStyle_trafficControlRequiresLoadBalancer(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			// The application must exist before the deployment group is planned for its compute platform to be known.
+			{
+				Config: testAccDeploymentGroupConfig_base(rName),
+			},
+			{
+				Config:      testAccDeploymentGroupConfig_trafficControlWithoutLoadBalancer(rName),
+				ExpectError: regexache.MustCompile(`load_balancer_info is required when deployment_style.0.deployment_option is WITH_TRAFFIC_CONTROL`),
+			},
+		},
+	})
+}
+
+func TestAccDeployDeploymentGroup_deploymentConfigARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group types.DeploymentGroupInfo
+	resourceName := "aws_codedeploy_deployment_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentGroupConfig_deploymentConfigARN(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_config_name", "aws_codedeploy_deployment_config.test", "deployment_config_name"),
+				),
+			},
+			{
+				Config:   testAccDeploymentGroupConfig_deploymentConfigARN(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccDeployDeploymentGroup_DeploymentStyle_default(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group types.DeploymentGroupInfo
@@ -991,7 +1730,7 @@ func TestAccDeployDeploymentGroup_LoadBalancerInfoTargetGroupInfo_create(t *test
 
 func TestAccDeployDeploymentGroup_LoadBalancerInfoTargetGroupInfo_update(t *testing.T) {
 	ctx := acctest.Context(t)
-	var group types.DeploymentGroupInfo
+	var group1, group2 types.DeploymentGroupInfo
 	resourceName := "aws_codedeploy_deployment_group.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -1004,7 +1743,7 @@ func TestAccDeployDeploymentGroup_LoadBalancerInfoTargetGroupInfo_update(t *test
 			{
 				Config: testAccDeploymentGroupConfig_loadBalancerInfoTargetInfoCreate(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentGroupExists(ctx, resourceName, &group),
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group1),
 					resource.TestCheckResourceAttr(resourceName, "load_balancer_info.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "load_balancer_info.0.target_group_info.#", "1"),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "load_balancer_info.0.target_group_info.*", map[string]string{
@@ -1014,8 +1753,14 @@ func TestAccDeployDeploymentGroup_LoadBalancerInfoTargetGroupInfo_update(t *test
 			},
 			{
 				Config: testAccDeploymentGroupConfig_loadBalancerInfoTargetInfoUpdate(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentGroupExists(ctx, resourceName, &group),
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group2),
+					testAccCheckDeploymentGroupNotRecreated(&group1, &group2),
 					resource.TestCheckResourceAttr(resourceName, "load_balancer_info.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "load_balancer_info.0.target_group_info.#", "1"),
 					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "load_balancer_info.0.target_group_info.*", map[string]string{
@@ -1289,6 +2034,34 @@ func TestAccDeployDeploymentGroup_BlueGreenDeployment_updateWithASG(t *testing.T
 	})
 }
 
+func TestAccDeployDeploymentGroup_BlueGreenDeployment_copyASGRequiresASG(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group types.DeploymentGroupInfo
+	resourceName := "aws_codedeploy_deployment_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDeploymentGroupConfig_blueGreenConfigCopyASGNoASG(rName),
+				ExpectError: regexache.MustCompile(`"COPY_AUTO_SCALING_GROUP" requires at least one autoscaling_groups entry`),
+			},
+			{
+				Config: testAccDeploymentGroupConfig_blueGreenConfigCreateASG(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "autoscaling_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_deployment_config.0.green_fleet_provisioning_option.0.action", "COPY_AUTO_SCALING_GROUP"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDeployDeploymentGroup_BlueGreenDeployment_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group types.DeploymentGroupInfo
@@ -1459,7 +2232,7 @@ func TestAccDeployDeploymentGroup_BlueGreenDeployment_complete(t *testing.T) {
 
 func TestAccDeployDeploymentGroup_ECS_blueGreen(t *testing.T) {
 	ctx := acctest.Context(t)
-	var group types.DeploymentGroupInfo
+	var group1, group2 types.DeploymentGroupInfo
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	ecsClusterResourceName := "aws_ecs_cluster.test"
 	ecsServiceResourceName := "aws_ecs_service.test"
@@ -1476,7 +2249,7 @@ func TestAccDeployDeploymentGroup_ECS_blueGreen(t *testing.T) {
 			{
 				Config: testAccDeploymentGroupConfig_ecsBlueGreen(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentGroupExists(ctx, resourceName, &group),
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group1),
 					resource.TestCheckResourceAttr(resourceName, "ecs_service.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "ecs_service.0.cluster_name", ecsClusterResourceName, names.AttrName),
 					resource.TestCheckResourceAttrPair(resourceName, "ecs_service.0.service_name", ecsServiceResourceName, names.AttrName),
@@ -1495,8 +2268,14 @@ func TestAccDeployDeploymentGroup_ECS_blueGreen(t *testing.T) {
 			},
 			{
 				Config: testAccDeploymentGroupConfig_ecsBlueGreenUpdate(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDeploymentGroupExists(ctx, resourceName, &group),
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group2),
+					testAccCheckDeploymentGroupNotRecreated(&group1, &group2),
 					resource.TestCheckResourceAttr(resourceName, "ecs_service.#", "1"),
 					resource.TestCheckResourceAttrPair(resourceName, "ecs_service.0.cluster_name", ecsClusterResourceName, names.AttrName),
 					resource.TestCheckResourceAttrPair(resourceName, "ecs_service.0.service_name", ecsServiceResourceName, names.AttrName),
@@ -1628,6 +2407,77 @@ func TestAccDeployDeploymentGroup_TermationHook_disabled(t *testing.T) {
 	})
 }
 
+func TestAccDeployDeploymentGroup_lastAttemptedDeployment(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group types.DeploymentGroupInfo
+	resourceName := "aws_codedeploy_deployment_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentGroupConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "last_attempted_deployment.#", "0"),
+					testAccCheckDeploymentGroupCreateFailedDeployment(ctx, &group),
+				),
+			},
+			{
+				Config: testAccDeploymentGroupConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "last_attempted_deployment.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_attempted_deployment.0.create_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_attempted_deployment.0.deployment_id"),
+					resource.TestCheckResourceAttr(resourceName, "last_attempted_deployment.0.status", string(types.DeploymentStatusFailed)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentGroupCreateFailedDeployment(ctx context.Context, group *types.DeploymentGroupInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeployClient(ctx)
+
+		// The deployment group's EC2 tag filter matches no instances, so the deployment fails.
+		output, err := conn.CreateDeployment(ctx, &codedeploy.CreateDeploymentInput{
+			ApplicationName:     group.ApplicationName,
+			DeploymentGroupName: group.DeploymentGroupName,
+			Revision: &types.RevisionLocation{
+				RevisionType: types.RevisionLocationTypeS3,
+				S3Location: &types.S3Location{
+					Bucket:     aws.String("tf-acc-test-nonexistent-bucket"),
+					BundleType: types.BundleTypeZip,
+					Key:        aws.String("revision.zip"),
+				},
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfresource.RetryUntilEqual(ctx, 5*time.Minute, types.DeploymentStatusFailed, func() (types.DeploymentStatus, error) {
+			output, err := conn.GetDeployment(ctx, &codedeploy.GetDeploymentInput{
+				DeploymentId: output.DeploymentId,
+			})
+
+			if err != nil {
+				return "", err
+			}
+
+			return output.DeploymentInfo.Status, nil
+		})
+
+		return err
+	}
+}
+
 func testAccCheckDeploymentGroupTriggerEvents(group *types.DeploymentGroupInfo, triggerName string, expectedEvents []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		found := false
@@ -1730,6 +2580,16 @@ func testAccCheckDeploymentGroupExists(ctx context.Context, n string, v *types.D
 	}
 }
 
+func testAccCheckDeploymentGroupNotRecreated(i, j *types.DeploymentGroupInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(i.DeploymentGroupId) != aws.ToString(j.DeploymentGroupId) {
+			return errors.New("CodeDeploy Deployment Group was recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccDeploymentGroupImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
 	return func(s *terraform.State) (string, error) {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -1840,6 +2700,74 @@ resource "aws_codedeploy_deployment_group" "test" {
 `, rName, tagGroupOrFilter))
 }
 
+func testAccDeploymentGroupConfig_ecsApplication(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codedeploy_app" "ecs" {
+  compute_platform = "ECS"
+  name             = "%[1]s-ecs"
+}
+`, rName))
+}
+
+func testAccDeploymentGroupConfig_ecsWithoutECSService(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_ecsApplication(rName), fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name               = aws_codedeploy_app.ecs.name
+  deployment_config_name = "CodeDeployDefault.ECSAllAtOnce"
+  deployment_group_name  = %[1]q
+  service_role_arn       = aws_iam_role.test.arn
+}
+`, rName))
+}
+
+func testAccDeploymentGroupConfig_checkNameAvailability(rName string, duplicate bool) string {
+	var duplicateGroup string
+	if duplicate {
+		duplicateGroup = fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "duplicate" {
+  app_name                = aws_codedeploy_app.test.name
+  deployment_group_name   = %[1]q
+  service_role_arn        = aws_iam_role.test.arn
+  check_name_availability = true
+}
+`, rName)
+	}
+
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name              = aws_codedeploy_app.test.name
+  deployment_group_name = %[1]q
+  service_role_arn      = aws_iam_role.test.arn
+}
+`, rName), duplicateGroup)
+}
+
+func testAccDeploymentGroupConfig_deploymentConfigARN(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codedeploy_deployment_config" "test" {
+  deployment_config_name = %[1]q
+
+  minimum_healthy_hosts {
+    type  = "HOST_COUNT"
+    value = 1
+  }
+}
+
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name               = aws_codedeploy_app.test.name
+  deployment_group_name  = %[1]q
+  service_role_arn       = aws_iam_role.test.arn
+  deployment_config_name = aws_codedeploy_deployment_config.test.arn
+
+  ec2_tag_filter {
+    key   = "filterkey"
+    type  = "KEY_AND_VALUE"
+    value = "filtervalue"
+  }
+}
+`, rName))
+}
+
 func testAccDeploymentGroupConfig_modified(rName string, tagGroup bool) string {
 	var tagGroupOrFilter string
 	if tagGroup {
@@ -1923,6 +2851,67 @@ EOF
 `, rName, tagGroupOrFilter))
 }
 
+func testAccDeploymentGroupConfig_serviceRoleARN(rName, roleResourceName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name              = aws_codedeploy_app.test.name
+  deployment_group_name = %[1]q
+  service_role_arn      = aws_iam_role.%[2]s.arn
+}
+
+resource "aws_iam_role_policy" "test_updated" {
+  name = "%[1]s-updated"
+  role = aws_iam_role.test_updated.id
+
+  policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "autoscaling:CompleteLifecycleAction",
+        "autoscaling:DeleteLifecycleHook",
+        "autoscaling:DescribeAutoScalingGroups",
+        "autoscaling:DescribeLifecycleHooks",
+        "autoscaling:PutLifecycleHook",
+        "autoscaling:RecordLifecycleActionHeartbeat",
+        "ec2:DescribeInstances",
+        "ec2:DescribeInstanceStatus",
+        "tag:GetTags",
+        "tag:GetResources"
+      ],
+      "Resource": "*"
+    }
+  ]
+}
+EOF
+}
+
+resource "aws_iam_role" "test_updated" {
+  name = "%[1]s-updated"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "",
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "codedeploy.${data.aws_partition.current.dns_suffix}"
+        ]
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+EOF
+}
+`, rName, roleResourceName))
+}
+
 func testAccDeploymentGroupConfig_onPremiseTags(rName string) string {
 	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
 resource "aws_codedeploy_deployment_group" "test" {
@@ -2138,6 +3127,32 @@ resource "aws_codedeploy_deployment_group" "test" {
 `, rName))
 }
 
+func testAccDeploymentGroupConfig_triggersAndAlarms(rName, groupName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name              = aws_codedeploy_app.test.name
+  deployment_group_name = %[1]q
+  service_role_arn      = aws_iam_role.test.arn
+
+  trigger_configuration {
+    trigger_events     = ["DeploymentFailure"]
+    trigger_name       = "test-trigger"
+    trigger_target_arn = aws_sns_topic.test.arn
+  }
+
+  alarm_configuration {
+    alarms  = ["test-alarm"]
+    enabled = true
+  }
+
+  auto_rollback_configuration {
+    enabled = true
+    events  = ["DEPLOYMENT_FAILURE"]
+  }
+}
+`, groupName))
+}
+
 func testAccDeploymentGroupConfig_styleDefault(rName string) string {
 	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
 resource "aws_codedeploy_deployment_group" "test" {
@@ -2184,6 +3199,21 @@ resource "aws_codedeploy_deployment_group" "test" {
 `, rName))
 }
 
+func testAccDeploymentGroupConfig_trafficControlWithoutLoadBalancer(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name              = aws_codedeploy_app.test.name
+  deployment_group_name = %[1]q
+  service_role_arn      = aws_iam_role.test.arn
+
+  deployment_style {
+    deployment_option = "WITH_TRAFFIC_CONTROL"
+    deployment_type   = "BLUE_GREEN"
+  }
+}
+`, rName))
+}
+
 func testAccDeploymentGroupConfig_loadBalancerInfoNone(rName string) string {
 	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
 resource "aws_codedeploy_deployment_group" "test" {
@@ -2441,6 +3471,43 @@ resource "aws_codedeploy_deployment_group" "test" {
 `, rName))
 }
 
+func testAccDeploymentGroupConfig_blueGreenConfigCopyASGNoASG(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentGroupConfig_base(rName), fmt.Sprintf(`
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name              = aws_codedeploy_app.test.name
+  deployment_group_name = %[1]q
+  service_role_arn      = aws_iam_role.test.arn
+
+  deployment_style {
+    deployment_option = "WITH_TRAFFIC_CONTROL"
+    deployment_type   = "BLUE_GREEN"
+  }
+
+  load_balancer_info {
+    elb_info {
+      name = "acc-test-codedeploy-dep-group"
+    }
+  }
+
+  blue_green_deployment_config {
+    deployment_ready_option {
+      action_on_timeout    = "STOP_DEPLOYMENT"
+      wait_time_in_minutes = 60
+    }
+
+    green_fleet_provisioning_option {
+      action = "COPY_AUTO_SCALING_GROUP"
+    }
+
+    terminate_blue_instances_on_deployment_success {
+      action                           = "TERMINATE"
+      termination_wait_time_in_minutes = 120
+    }
+  }
+}
+`, rName))
+}
+
 func testAccDeploymentGroupConfig_blueGreenConfigUpdateASG(rName string) string {
 	return acctest.ConfigCompose(testAccDeploymentGroupConfig_baseBlueGreenConfigASG(rName), fmt.Sprintf(`
 resource "aws_codedeploy_deployment_group" "test" {
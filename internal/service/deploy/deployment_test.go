@@ -0,0 +1,426 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package deploy_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodedeploy "github.com/hashicorp/terraform-provider-aws/internal/service/deploy"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestWaitDeploymentSucceeded_pollInterval(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const pollInterval = 50 * time.Millisecond
+
+	var reads []time.Time
+	conn := testDeploymentStubClient([]types.DeploymentStatus{
+		types.DeploymentStatusInProgress,
+		types.DeploymentStatusInProgress,
+		types.DeploymentStatusSucceeded,
+	}, nil, &reads)
+
+	output, err := tfcodedeploy.WaitDeploymentSucceeded(ctx, conn, "d-TEST12345", pollInterval, time.Minute)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := output.Status, types.DeploymentStatusSucceeded; got != want {
+		t.Errorf("status = %s, want %s", got, want)
+	}
+
+	if got, want := len(reads), 3; got != want {
+		t.Fatalf("GetDeployment calls = %d, want %d", got, want)
+	}
+
+	for i := 1; i < len(reads); i++ {
+		if gap := reads[i].Sub(reads[i-1]); gap < pollInterval {
+			t.Errorf("GetDeployment call %d came %s after the previous one, want at least %s", i, gap, pollInterval)
+		}
+	}
+}
+
+func TestResourceDeploymentCreate(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	testCases := map[string]struct {
+		status               types.DeploymentStatus
+		errorInformation     *types.ErrorInformation
+		expectedError        *regexp.Regexp
+		expectedErrorCode    string
+		expectedErrorMessage string
+	}{
+		"succeeded": {
+			status: types.DeploymentStatusSucceeded,
+		},
+		"failed": {
+			status: types.DeploymentStatusFailed,
+			errorInformation: &types.ErrorInformation{
+				Code:    types.ErrorCodeHealthConstraints,
+				Message: aws.String("The overall deployment failed because too many individual instances failed deployment"),
+			},
+			expectedError:        regexache.MustCompile(`HEALTH_CONSTRAINTS: The overall deployment failed because too many individual instances failed deployment`),
+			expectedErrorCode:    "HEALTH_CONSTRAINTS",
+			expectedErrorMessage: "The overall deployment failed because too many individual instances failed deployment",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			meta := &conns.AWSClient{}
+			conns.SetClient(meta, names.Deploy, testDeploymentStubClient([]types.DeploymentStatus{testCase.status}, testCase.errorInformation, nil))
+
+			r := tfcodedeploy.ResourceDeployment()
+			d := schema.TestResourceDataRaw(t, r.SchemaMap(), map[string]interface{}{
+				"app_name":              "test",
+				"deployment_group_name": "test",
+				names.AttrDescription:   "release 1.2.3",
+				"revision": []interface{}{
+					map[string]interface{}{
+						"s3_location": []interface{}{
+							map[string]interface{}{
+								names.AttrBucket: "test",
+								"bundle_type":    "zip",
+								names.AttrKey:    "test.zip",
+							},
+						},
+					},
+				},
+			})
+
+			diags := r.CreateWithoutTimeout(ctx, d, meta)
+
+			if testCase.expectedError == nil {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+			} else {
+				var found bool
+				for _, v := range diags {
+					if v.Severity == diag.Error && testCase.expectedError.MatchString(v.Summary) {
+						found = true
+					}
+				}
+
+				if !found {
+					t.Errorf("expected error matching %q, got %v", testCase.expectedError, diags)
+				}
+			}
+
+			if got, want := d.Id(), "d-TEST12345"; got != want {
+				t.Errorf("id = %q, want %q", got, want)
+			}
+
+			if got, want := d.Get(names.AttrDescription).(string), "release 1.2.3"; got != want {
+				t.Errorf("description = %q, want %q", got, want)
+			}
+
+			if got, want := d.Get("revision.0.s3_location.0.bucket").(string), "test"; got != want {
+				t.Errorf("revision.0.s3_location.0.bucket = %q, want %q", got, want)
+			}
+
+			if got, want := d.Get(names.AttrStatus).(string), string(testCase.status); got != want {
+				t.Errorf("status = %q, want %q", got, want)
+			}
+
+			if got, want := d.Get("error_code").(string), testCase.expectedErrorCode; got != want {
+				t.Errorf("error_code = %q, want %q", got, want)
+			}
+
+			if got, want := d.Get("error_message").(string), testCase.expectedErrorMessage; got != want {
+				t.Errorf("error_message = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+// testDeploymentStubClient returns a client that accepts a new deployment and then reports each of statuses in turn,
+// repeating the last, when the deployment is read. errorInformation is reported once the deployment has failed.
+// The time of each read is appended to reads, if set.
+func testDeploymentStubClient(statuses []types.DeploymentStatus, errorInformation *types.ErrorInformation, reads *[]time.Time) *codedeploy.Client {
+	var mu sync.Mutex
+	var created *codedeploy.CreateDeploymentInput
+	var n int
+
+	return codedeploy.New(codedeploy.Options{
+		Region: "us-west-2", //lintignore:AWSAT003
+		APIOptions: []func(*middleware.Stack) error{
+			func(stack *middleware.Stack) error {
+				return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("stubDeployment",
+					func(_ context.Context, in middleware.InitializeInput, _ middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+						mu.Lock()
+						defer mu.Unlock()
+
+						switch params := in.Parameters.(type) {
+						case *codedeploy.CreateDeploymentInput:
+							created = params
+
+							return middleware.InitializeOutput{Result: &codedeploy.CreateDeploymentOutput{
+								DeploymentId: aws.String("d-TEST12345"),
+							}}, middleware.Metadata{}, nil
+						case *codedeploy.GetDeploymentInput:
+							if reads != nil {
+								*reads = append(*reads, time.Now())
+							}
+
+							output := &types.DeploymentInfo{
+								DeploymentId: params.DeploymentId,
+								Status:       statuses[min(n, len(statuses)-1)],
+							}
+							n++
+
+							if created != nil {
+								output.ApplicationName = created.ApplicationName
+								output.DeploymentConfigName = aws.String("CodeDeployDefault.OneAtATime")
+								output.DeploymentGroupName = created.DeploymentGroupName
+								output.Description = created.Description
+								output.Revision = created.Revision
+							}
+
+							if output.Status == types.DeploymentStatusFailed {
+								output.ErrorInformation = errorInformation
+							}
+
+							return middleware.InitializeOutput{Result: &codedeploy.GetDeploymentOutput{
+								DeploymentInfo: output,
+							}}, middleware.Metadata{}, nil
+						}
+
+						return middleware.InitializeOutput{}, middleware.Metadata{}, fmt.Errorf("unexpected operation input: %T", in.Parameters)
+					}), middleware.Before)
+			},
+		},
+	})
+}
+
+func TestAccDeployDeployment_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var deployment types.DeploymentInfo
+	resourceName := "aws_codedeploy_deployment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DeployServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		// Deployments can't be deleted.
+		CheckDestroy: acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_base(rName, "v1"),
+			},
+			{
+				Config: testAccDeploymentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					resource.TestCheckResourceAttrPair(resourceName, "app_name", "aws_codedeploy_app.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "deployment_config_name", "CodeDeployDefault.LambdaAllAtOnce"),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_group_name", "aws_codedeploy_deployment_group.test", "deployment_group_name"),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttr(resourceName, "error_code", ""),
+					resource.TestCheckResourceAttr(resourceName, "error_message", ""),
+					resource.TestCheckResourceAttr(resourceName, "poll_interval", "15s"),
+					resource.TestCheckResourceAttr(resourceName, "revision.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "revision.0.app_spec_content.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rollback_info.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Succeeded"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_pollInterval(rName, "30s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					resource.TestCheckResourceAttr(resourceName, "poll_interval", "30s"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDeploymentExists(ctx context.Context, n string, v *types.DeploymentInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DeployClient(ctx)
+
+		output, err := tfcodedeploy.FindDeploymentByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// testAccDeploymentConfig_base publishes a version of a Lambda function for each distinct description.
+// The alias stays on version 1 until a deployment shifts it.
+func testAccDeploymentConfig_base(rName, functionDescription string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "lambda.${data.aws_partition.current.dns_suffix}" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  description   = %[2]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+  publish       = true
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = "live"
+  function_name    = aws_lambda_function.test.function_name
+  function_version = "1"
+
+  lifecycle {
+    ignore_changes = [function_version]
+  }
+}
+
+resource "aws_codedeploy_app" "test" {
+  name             = %[1]q
+  compute_platform = "Lambda"
+}
+
+resource "aws_iam_role" "codedeploy" {
+  name = "%[1]s-codedeploy"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "codedeploy.${data.aws_partition.current.dns_suffix}" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "codedeploy" {
+  role       = aws_iam_role.codedeploy.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/service-role/AWSCodeDeployRoleForLambda"
+}
+
+resource "aws_codedeploy_deployment_group" "test" {
+  app_name               = aws_codedeploy_app.test.name
+  deployment_group_name  = %[1]q
+  deployment_config_name = "CodeDeployDefault.LambdaAllAtOnce"
+  service_role_arn       = aws_iam_role.codedeploy.arn
+
+  deployment_style {
+    deployment_option = "WITH_TRAFFIC_CONTROL"
+    deployment_type   = "BLUE_GREEN"
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.codedeploy]
+}
+`, rName, functionDescription)
+}
+
+func testAccDeploymentConfig_description(rName, description string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, "v2"), fmt.Sprintf(`
+resource "aws_codedeploy_deployment" "test" {
+  app_name              = aws_codedeploy_app.test.name
+  deployment_group_name = aws_codedeploy_deployment_group.test.deployment_group_name
+  description           = %[1]q
+
+  revision {
+    app_spec_content {
+      content = jsonencode({
+        version = 0.0
+        Resources = [{
+          function = {
+            Type = "AWS::Lambda::Function"
+            Properties = {
+              Name           = aws_lambda_function.test.function_name
+              Alias          = aws_lambda_alias.test.name
+              CurrentVersion = "1"
+              TargetVersion  = aws_lambda_function.test.version
+            }
+          }
+        }]
+      })
+    }
+  }
+}
+`, description))
+}
+
+func testAccDeploymentConfig_basic(rName string) string {
+	return testAccDeploymentConfig_description(rName, rName)
+}
+
+func testAccDeploymentConfig_pollInterval(rName, pollInterval string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, "v2"), fmt.Sprintf(`
+resource "aws_codedeploy_deployment" "test" {
+  app_name              = aws_codedeploy_app.test.name
+  deployment_group_name = aws_codedeploy_deployment_group.test.deployment_group_name
+  description           = %[1]q
+  poll_interval         = %[2]q
+
+  revision {
+    app_spec_content {
+      content = jsonencode({
+        version = 0.0
+        Resources = [{
+          function = {
+            Type = "AWS::Lambda::Function"
+            Properties = {
+              Name           = aws_lambda_function.test.function_name
+              Alias          = aws_lambda_alias.test.name
+              CurrentVersion = "1"
+              TargetVersion  = aws_lambda_function.test.version
+            }
+          }
+        }]
+      })
+    }
+  }
+}
+`, rName, pollInterval))
+}
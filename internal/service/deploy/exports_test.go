@@ -6,10 +6,49 @@ package deploy
 // Exports for use in tests only.
 var (
 	ResourceApp              = resourceApp
+	ResourceDeployment       = resourceDeployment       // nosemgrep:ci.deploy-in-var-name
 	ResourceDeploymentConfig = resourceDeploymentConfig // nosemgrep:ci.deploy-in-var-name
 	ResourceDeploymentGroup  = resourceDeploymentGroup  // nosemgrep:ci.deploy-in-var-name
 
 	FindApplicationByName           = findApplicationByName
+	FindDeploymentByID              = findDeploymentByID              // nosemgrep:ci.deploy-in-var-name
 	FindDeploymentConfigByName      = findDeploymentConfigByName      // nosemgrep:ci.deploy-in-var-name
 	FindDeploymentGroupByTwoPartKey = findDeploymentGroupByTwoPartKey // nosemgrep:ci.deploy-in-var-name
+
+	CheckDeploymentGroupNameAvailable                = checkDeploymentGroupNameAvailable // nosemgrep:ci.deploy-in-var-name
+	DefaultMinimumHealthyHosts                       = defaultMinimumHealthyHosts
+	DeploymentConfigARN                              = deploymentConfigARN                    // nosemgrep:ci.deploy-in-var-name
+	DeploymentConfigName                             = deploymentConfigName                   // nosemgrep:ci.deploy-in-var-name
+	DeploymentConfigTrafficRoutingHash               = deploymentConfigTrafficRoutingHash     // nosemgrep:ci.deploy-in-var-name
+	DeploymentConfigNameFromNameOrARN                = deploymentConfigNameFromNameOrARN      // nosemgrep:ci.deploy-in-var-name
+	DeploymentConfigSchemaValidationErrors           = deploymentConfigSchemaValidationErrors // nosemgrep:ci.deploy-in-var-name
+	DeploymentConfigValidationErrors                 = deploymentConfigValidationErrors       // nosemgrep:ci.deploy-in-var-name
+	ExpandMinimumHealthyHosts                        = expandMinimumHealthyHosts
+	FindApplicationComputePlatform                   = findApplicationComputePlatform
+	FindDeploymentGroupByTwoPartKeyWhenNew           = findDeploymentGroupByTwoPartKeyWhenNew       // nosemgrep:ci.deploy-in-var-name
+	FindDeploymentGroupIDsByDeploymentConfigName     = findDeploymentGroupIDsByDeploymentConfigName // nosemgrep:ci.deploy-in-var-name
+	FlattenMinimumHealthHostsPerZone                 = flattenMinimumHealthHostsPerZone
+	FlattenTrafficRoutingConfig                      = flattenTrafficRoutingConfig
+	FlattenTriggerConfigs                            = flattenTriggerConfigs
+	FlattenZonalConfig                               = flattenZonalConfig
+	MinimumHealthyHostsDescription                   = minimumHealthyHostsDescription
+	MinimumHealthyHostsWithPerZone                   = minimumHealthyHostsWithPerZone
+	ParseMinimumHealthyHostsValueString              = parseMinimumHealthyHostsValueString
+	ReplaceDeploymentConfig                          = replaceDeploymentConfig         // nosemgrep:ci.deploy-in-var-name
+	SetDeploymentConfigRawJSON                       = setDeploymentConfigRawJSON      // nosemgrep:ci.deploy-in-var-name
+	SetDeploymentConfigResourceData                  = setDeploymentConfigResourceData // nosemgrep:ci.deploy-in-var-name
+	SuppressOmittedOutdatedInstancesStrategy         = suppressOmittedOutdatedInstancesStrategy
+	TimeBasedLinearPartialLastStep                   = timeBasedLinearPartialLastStep
+	TrafficRoutingConfigChanged                      = trafficRoutingConfigChanged
+	ValidateCreateDeploymentConfigInput              = validateCreateDeploymentConfigInput       // nosemgrep:ci.deploy-in-var-name
+	ValidateDeploymentConfigNameLength               = validateDeploymentConfigNameLength        // nosemgrep:ci.deploy-in-var-name
+	ValidateDeploymentGroupForComputePlatform        = validateDeploymentGroupForComputePlatform // nosemgrep:ci.deploy-in-var-name
+	ValidateDeploymentGroupLoadBalancerInfo          = validateDeploymentGroupLoadBalancerInfo   // nosemgrep:ci.deploy-in-var-name
+	ValidateTrafficRoutingConsistency                = validateTrafficRoutingConsistency
+	ValidateTrafficRoutingIntervalForComputePlatform = validateTrafficRoutingIntervalForComputePlatform
+	ValidateTrafficRoutingTypeConfigured             = validateTrafficRoutingTypeConfigured
+	ValidateTrafficRoutingTypeForComputePlatform     = validateTrafficRoutingTypeForComputePlatform
+	ValidateZonalConfigForComputePlatform            = validateZonalConfigForComputePlatform
+	WaitDeploymentSucceeded                          = waitDeploymentSucceeded // nosemgrep:ci.deploy-in-var-name
+	ZonalConfigMissingMinimumHealthyHostsPerZone     = zonalConfigMissingMinimumHealthyHostsPerZone
 )
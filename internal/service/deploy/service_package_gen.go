@@ -23,7 +23,26 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
-	return []*types.ServicePackageSDKDataSource{}
+	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceDeploymentConfig,
+			TypeName: "aws_codedeploy_deployment_config",
+			Name:     "Deployment Config",
+		},
+		{
+			Factory:  dataSourceDeploymentConfigValidation,
+			TypeName: "aws_codedeploy_deployment_config_validation",
+			Name:     "Deployment Config Validation",
+		},
+		{
+			Factory:  dataSourceDeploymentGroup,
+			TypeName: "aws_codedeploy_deployment_group",
+			Name:     "Deployment Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
@@ -36,6 +55,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceDeployment,
+			TypeName: "aws_codedeploy_deployment",
+			Name:     "Deployment",
+		},
 		{
 			Factory:  resourceDeploymentConfig,
 			TypeName: "aws_codedeploy_deployment_config",
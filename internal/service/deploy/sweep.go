@@ -6,6 +6,7 @@ package deploy
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -14,11 +15,23 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	deploymentConfigSweepConcurrency = 10
+)
+
 func RegisterSweepers() {
 	resource.AddTestSweepers("aws_codedeploy_app", &resource.Sweeper{
 		Name: "aws_codedeploy_app",
 		F:    sweepApps,
 	})
+
+	resource.AddTestSweepers("aws_codedeploy_deployment_config", &resource.Sweeper{
+		Name: "aws_codedeploy_deployment_config",
+		F:    sweepDeploymentConfigs,
+		Dependencies: []string{
+			"aws_codedeploy_app",
+		},
+	})
 }
 
 func sweepApps(region string) error {
@@ -62,3 +75,50 @@ func sweepApps(region string) error {
 
 	return nil
 }
+
+func sweepDeploymentConfigs(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.DeployClient(ctx)
+	input := &codedeploy.ListDeploymentConfigsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := codedeploy.NewListDeploymentConfigsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CodeDeploy Deployment Config sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CodeDeploy Deployment Configs (%s): %w", region, err)
+		}
+
+		for _, v := range page.DeploymentConfigsList {
+			if strings.HasPrefix(v, deploymentConfigNameManagedPrefix) {
+				log.Printf("[INFO] Skipping CodeDeploy Deployment Config %s: AWS-managed", v)
+				continue
+			}
+
+			r := resourceDeploymentConfig()
+			d := r.Data(nil)
+			d.SetId(v)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
+
+	// Accounts used for testing can accumulate thousands of configs; bound concurrency to avoid throttling.
+	err = sweep.SweepOrchestratorWithConcurrency(ctx, sweepResources, deploymentConfigSweepConcurrency)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CodeDeploy Deployment Configs (%s): %w", region, err)
+	}
+
+	return nil
+}
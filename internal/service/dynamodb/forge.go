@@ -46,3 +46,16 @@ func stripOnDemandThroughputAttributes(in map[string]interface{}) (map[string]in
 
 	return m, nil
 }
+
+func stripWarmThroughputAttributes(in map[string]interface{}) (map[string]interface{}, error) {
+	mapCopy, err := copystructure.Copy(in)
+	if err != nil {
+		return nil, err
+	}
+
+	m := mapCopy.(map[string]interface{})
+
+	delete(m, "warm_throughput")
+
+	return m, nil
+}
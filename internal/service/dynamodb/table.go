@@ -43,6 +43,8 @@ import (
 const (
 	provisionedThroughputMinValue = 1
 	resNameTable                  = "Table"
+	warmThroughputReadMinValue    = 12000
+	warmThroughputWriteMinValue   = 4000
 )
 
 // @SDKResource("aws_dynamodb_table", name="Table")
@@ -73,6 +75,9 @@ func resourceTable() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 				return validateTableAttributes(diff)
 			},
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return validateReplicaConsistencyMode(diff)
+			},
 			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 				if diff.Id() != "" && diff.HasChange("server_side_encryption") {
 					o, n := diff.GetChange("server_side_encryption")
@@ -126,6 +131,13 @@ func resourceTable() *schema.Resource {
 			customdiff.ForceNewIfChange("restore_source_table_arn", func(_ context.Context, old, new, meta interface{}) bool {
 				return old.(string) != new.(string) && new.(string) != ""
 			}),
+			// Warm throughput can only be increased.
+			customdiff.ForceNewIfChange("warm_throughput.0.read_units_per_second", func(_ context.Context, old, new, meta interface{}) bool {
+				return new.(int) != 0 && new.(int) < old.(int)
+			}),
+			customdiff.ForceNewIfChange("warm_throughput.0.write_units_per_second", func(_ context.Context, old, new, meta interface{}) bool {
+				return new.(int) != 0 && new.(int) < old.(int)
+			}),
 			validateTTLCustomDiff,
 			verify.SetTagsDiff,
 		),
@@ -200,6 +212,25 @@ func resourceTable() *schema.Resource {
 							Optional: true,
 							Computed: true,
 						},
+						"warm_throughput": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"read_units_per_second": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(warmThroughputReadMinValue),
+									},
+									"write_units_per_second": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(warmThroughputWriteMinValue),
+									},
+								},
+							},
+						},
 						"write_capacity": {
 							Type:     schema.TypeInt,
 							Optional: true,
@@ -286,6 +317,13 @@ func resourceTable() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"consistency_mode": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          awstypes.MultiRegionConsistencyEventual,
+							ValidateDiagFunc: enum.Validate[awstypes.MultiRegionConsistency](),
+							// can only be set when the global table is created
+						},
 						names.AttrKMSKeyARN: {
 							Type:         schema.TypeString,
 							Optional:     true,
@@ -491,6 +529,28 @@ func resourceTable() *schema.Resource {
 				},
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
 			},
+			"warm_throughput": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"read_units_per_second": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(warmThroughputReadMinValue),
+						},
+						"write_units_per_second": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(warmThroughputWriteMinValue),
+						},
+					},
+				},
+			},
 			"write_capacity": {
 				Type:          schema.TypeInt,
 				Computed:      true,
@@ -762,6 +822,10 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.TableClass = awstypes.TableClass(v.(string))
 		}
 
+		if v, ok := d.GetOk("warm_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.WarmThroughput = expandWarmThroughput(v.([]interface{})[0].(map[string]interface{}))
+		}
+
 		_, err := tfresource.RetryWhen(ctx, createTableTimeout, func() (interface{}, error) {
 			return conn.CreateTable(ctx, input)
 		}, func(err error) (bool, error) {
@@ -803,6 +867,19 @@ func resourceTableCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	if v, ok := d.GetOk("warm_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		warmThroughput := expandWarmThroughput(v.([]interface{})[0].(map[string]interface{}))
+
+		// Tables restored from a backup or imported from S3 are created with the default warm throughput.
+		if nameOk || arnOk || len(d.Get("import_table").([]interface{})) > 0 {
+			if err := updateWarmThroughput(ctx, conn, d.Id(), warmThroughput, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, d.Id(), err)
+			}
+		} else if _, err := waitTableWarmThroughputActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForCreation, resNameTable, d.Id(), fmt.Errorf("warm throughput: %w", err))
+		}
+	}
+
 	if d.Get("ttl.0.enabled").(bool) {
 		if err := updateTimeToLive(ctx, conn, d.Id(), d.Get("ttl").([]interface{}), d.Timeout(schema.TimeoutCreate)); err != nil {
 			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionCreating, resNameTable, d.Id(), fmt.Errorf("enabling TTL: %w", err))
@@ -878,7 +955,10 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "local_secondary_index", err)
 	}
 
-	if err := d.Set("global_secondary_index", flattenTableGlobalSecondaryIndex(table.GlobalSecondaryIndexes)); err != nil {
+	gsis := flattenTableGlobalSecondaryIndex(table.GlobalSecondaryIndexes)
+	gsis = clearGSIDefaultWarmThroughputs(d.Get("global_secondary_index").(*schema.Set), gsis)
+
+	if err := d.Set("global_secondary_index", gsis); err != nil {
 		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "global_secondary_index", err)
 	}
 
@@ -886,6 +966,10 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "on_demand_throughput", err)
 	}
 
+	if err := d.Set("warm_throughput", flattenTableWarmThroughput(table.WarmThroughput)); err != nil {
+		return create.AppendDiagSettingError(diags, names.DynamoDB, resNameTable, d.Id(), "warm_throughput", err)
+	}
+
 	if table.StreamSpecification != nil {
 		d.Set("stream_enabled", table.StreamSpecification.StreamEnabled)
 		d.Set("stream_view_type", table.StreamSpecification.StreamViewType)
@@ -914,6 +998,7 @@ func resourceTableRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionReading, resNameTable, d.Id(), err)
 	}
 
+	replicas = addReplicaConsistencyModes(table.MultiRegionConsistency, replicas)
 	replicas = addReplicaTagPropagates(d.Get("replica").(*schema.Set), replicas)
 	replicas = clearReplicaDefaultKeys(ctx, meta.(*conns.AWSClient), replicas)
 
@@ -1077,7 +1162,7 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	// Must update all indexes when switching BillingMode from PAY_PER_REQUEST to PROVISIONED
 	if newBillingMode == awstypes.BillingModeProvisioned {
 		for _, gsiUpdate := range gsiUpdates {
-			if gsiUpdate.Update == nil {
+			if gsiUpdate.Update == nil || gsiUpdate.Update.WarmThroughput != nil {
 				continue
 			}
 
@@ -1122,6 +1207,35 @@ func resourceTableUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	// Warm throughput cannot be changed concurrently with other values
+	if d.HasChange("warm_throughput") {
+		if v, ok := d.GetOk("warm_throughput"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			if err := updateWarmThroughput(ctx, conn, d.Id(), expandWarmThroughput(v.([]interface{})[0].(map[string]interface{})), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTable, d.Id(), err)
+			}
+		}
+	}
+
+	for _, gsiUpdate := range gsiUpdates {
+		if gsiUpdate.Update == nil || gsiUpdate.Update.WarmThroughput == nil {
+			continue
+		}
+
+		idxName := aws.ToString(gsiUpdate.Update.IndexName)
+		input := &dynamodb.UpdateTableInput{
+			GlobalSecondaryIndexUpdates: []awstypes.GlobalSecondaryIndexUpdate{gsiUpdate},
+			TableName:                   aws.String(d.Id()),
+		}
+
+		if _, err := conn.UpdateTable(ctx, input); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionUpdating, resNameTable, d.Id(), fmt.Errorf("GSI (%s) warm throughput: %w", idxName, err))
+		}
+
+		if _, err := waitGSIWarmThroughputActive(ctx, conn, d.Id(), idxName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.DynamoDB, create.ErrActionWaitingForUpdate, resNameTable, d.Id(), fmt.Errorf("GSI (%s) warm throughput: %w", idxName, err))
+		}
+	}
+
 	// Phase 3 of Global Secondary Index Operations: Create Only
 	// Only 1 online index can be created simultaneously per table
 	for _, gsiUpdate := range gsiUpdates {
@@ -1341,6 +1455,10 @@ func cycleStreamEnabled(ctx context.Context, conn *dynamodb.Client, id string, s
 }
 
 func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string, tfList []interface{}, create bool, timeout time.Duration) error {
+	if create && replicaConsistencyMode(tfList) == awstypes.MultiRegionConsistencyStrong {
+		return createStrongConsistencyReplicas(ctx, conn, tableName, tfList, timeout)
+	}
+
 	for _, tfMapRaw := range tfList {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
 
@@ -1443,6 +1561,99 @@ func createReplicas(ctx context.Context, conn *dynamodb.Client, tableName string
 	return nil
 }
 
+// createStrongConsistencyReplicas creates the replicas of a multi-Region strong consistency (MRSC) global table.
+// Unlike eventually consistent global tables, all replicas of an MRSC global table must be created in a single request.
+func createStrongConsistencyReplicas(ctx context.Context, conn *dynamodb.Client, tableName string, tfList []interface{}, timeout time.Duration) error {
+	input := &dynamodb.UpdateTableInput{
+		MultiRegionConsistency: awstypes.MultiRegionConsistencyStrong,
+		TableName:              aws.String(tableName),
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		var replicaInput = &awstypes.CreateReplicationGroupMemberAction{}
+
+		if v, ok := tfMap["region_name"].(string); ok && v != "" {
+			replicaInput.RegionName = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrKMSKeyARN].(string); ok && v != "" {
+			replicaInput.KMSMasterKeyId = aws.String(v)
+		}
+
+		input.ReplicaUpdates = append(input.ReplicaUpdates, awstypes.ReplicationGroupUpdate{
+			Create: replicaInput,
+		})
+	}
+
+	err := retry.RetryContext(ctx, max(replicaUpdateTimeout, timeout), func() *retry.RetryError {
+		_, err := conn.UpdateTable(ctx, input)
+		if err != nil {
+			if tfawserr.ErrCodeEquals(err, errCodeThrottlingException) {
+				return retry.RetryableError(err)
+			}
+			if errs.IsAErrorMessageContains[*awstypes.LimitExceededException](err, "can be created, updated, or deleted simultaneously") {
+				return retry.RetryableError(err)
+			}
+			if errs.IsA[*awstypes.ResourceInUseException](err) {
+				return retry.RetryableError(err)
+			}
+
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.UpdateTable(ctx, input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("creating replicas with %s consistency: %w", awstypes.MultiRegionConsistencyStrong, err)
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if _, err := waitReplicaActive(ctx, conn, tableName, tfMap["region_name"].(string), replicaDelayDefault, timeout); err != nil {
+			return fmt.Errorf("waiting for replica (%s) creation: %w", tfMap["region_name"].(string), err)
+		}
+
+		if err := updatePITR(ctx, conn, tableName, tfMap["point_in_time_recovery"].(bool), tfMap["region_name"].(string), timeout); err != nil {
+			return fmt.Errorf("updating replica (%s) point in time recovery: %w", tfMap["region_name"].(string), err)
+		}
+	}
+
+	return nil
+}
+
+// replicaConsistencyMode returns the multi-Region consistency mode of the specified replicas.
+// All replicas of a global table share the same consistency mode.
+func replicaConsistencyMode(tfList []interface{}) awstypes.MultiRegionConsistency {
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["consistency_mode"].(string); ok && v != "" {
+			return awstypes.MultiRegionConsistency(v)
+		}
+	}
+
+	return awstypes.MultiRegionConsistencyEventual
+}
+
 func updateReplicaTags(ctx context.Context, conn *dynamodb.Client, rn string, replicas []interface{}, newTags interface{}) error {
 	for _, tfMapRaw := range replicas {
 		tfMap, ok := tfMapRaw.(map[string]interface{})
@@ -1505,6 +1716,27 @@ func updateTimeToLive(ctx context.Context, conn *dynamodb.Client, tableName stri
 	return nil
 }
 
+func updateWarmThroughput(ctx context.Context, conn *dynamodb.Client, tableName string, warmThroughput *awstypes.WarmThroughput, timeout time.Duration) error {
+	input := &dynamodb.UpdateTableInput{
+		TableName:      aws.String(tableName),
+		WarmThroughput: warmThroughput,
+	}
+
+	if _, err := conn.UpdateTable(ctx, input); err != nil {
+		return fmt.Errorf("updating warm throughput: %w", err)
+	}
+
+	if _, err := waitTableActive(ctx, conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for warm throughput update: %w", err)
+	}
+
+	if _, err := waitTableWarmThroughputActive(ctx, conn, tableName, timeout); err != nil {
+		return fmt.Errorf("waiting for warm throughput update: %w", err)
+	}
+
+	return nil
+}
+
 func updatePITR(ctx context.Context, conn *dynamodb.Client, tableName string, enabled bool, region string, timeout time.Duration) error {
 	// pitr must be modified from region where the main/replica resides
 	log.Printf("[DEBUG] Updating DynamoDB point in time recovery status to %v (%s)", enabled, region)
@@ -1683,6 +1915,10 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 				c.OnDemandThroughput = expandOnDemandThroughput(v[0].(map[string]any))
 			}
 
+			if v, ok := m["warm_throughput"].([]any); ok && len(v) > 0 && v[0] != nil {
+				c.WarmThroughput = expandWarmThroughput(v[0].(map[string]any))
+			}
+
 			ops = append(ops, awstypes.GlobalSecondaryIndexUpdate{
 				Create: &c,
 			})
@@ -1716,6 +1952,23 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 				onDemandThroughputChanged = true
 			}
 
+			oldWarmThroughput := &awstypes.WarmThroughput{}
+			newWarmThroughput := &awstypes.WarmThroughput{}
+			if v, ok := oldMap["warm_throughput"].([]any); ok && len(v) > 0 && v[0] != nil {
+				oldWarmThroughput = expandWarmThroughput(v[0].(map[string]any))
+			}
+
+			if v, ok := newMap["warm_throughput"].([]any); ok && len(v) > 0 && v[0] != nil {
+				newWarmThroughput = expandWarmThroughput(v[0].(map[string]any))
+			}
+			// Warm throughput can only be increased, a decrease requires the index to be recreated.
+			var warmThroughputChanged, warmThroughputDecreased bool
+			if !reflect.DeepEqual(oldWarmThroughput, newWarmThroughput) {
+				warmThroughputChanged = true
+				warmThroughputDecreased = aws.ToInt64(newWarmThroughput.ReadUnitsPerSecond) < aws.ToInt64(oldWarmThroughput.ReadUnitsPerSecond) ||
+					aws.ToInt64(newWarmThroughput.WriteUnitsPerSecond) < aws.ToInt64(oldWarmThroughput.WriteUnitsPerSecond)
+			}
+
 			// pluck non_key_attributes from oldAttributes and newAttributes as reflect.DeepEquals will compare
 			// ordinal of elements in its equality (which we actually don't care about)
 			nonKeyAttributesChanged := checkIfNonKeyAttributesChanged(oldMap, newMap)
@@ -1744,7 +1997,15 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 			if err != nil {
 				return ops, err
 			}
-			otherAttributesChanged := nonKeyAttributesChanged || !reflect.DeepEqual(oldAttributes, newAttributes)
+			oldAttributes, err = stripWarmThroughputAttributes(oldAttributes)
+			if err != nil {
+				return ops, err
+			}
+			newAttributes, err = stripWarmThroughputAttributes(newAttributes)
+			if err != nil {
+				return ops, err
+			}
+			otherAttributesChanged := nonKeyAttributesChanged || warmThroughputDecreased || !reflect.DeepEqual(oldAttributes, newAttributes)
 
 			if capacityChanged && !otherAttributesChanged && billingMode == awstypes.BillingModeProvisioned {
 				update := awstypes.GlobalSecondaryIndexUpdate{
@@ -1770,12 +2031,28 @@ func updateDiffGSI(oldGsi, newGsi []interface{}, billingMode awstypes.BillingMod
 					},
 				})
 
+				c := awstypes.CreateGlobalSecondaryIndexAction{
+					IndexName:             aws.String(idxName),
+					KeySchema:             expandKeySchema(newMap),
+					ProvisionedThroughput: expandProvisionedThroughput(newMap, billingMode),
+					Projection:            expandProjection(newMap),
+				}
+
+				if v, ok := newMap["warm_throughput"].([]any); ok && len(v) > 0 && v[0] != nil {
+					c.WarmThroughput = expandWarmThroughput(v[0].(map[string]any))
+				}
+
 				ops = append(ops, awstypes.GlobalSecondaryIndexUpdate{
-					Create: &awstypes.CreateGlobalSecondaryIndexAction{
-						IndexName:             aws.String(idxName),
-						KeySchema:             expandKeySchema(newMap),
-						ProvisionedThroughput: expandProvisionedThroughput(newMap, billingMode),
-						Projection:            expandProjection(newMap),
+					Create: &c,
+				})
+			}
+
+			// Warm throughput is updated separately from other index settings.
+			if warmThroughputChanged && !otherAttributesChanged {
+				ops = append(ops, awstypes.GlobalSecondaryIndexUpdate{
+					Update: &awstypes.UpdateGlobalSecondaryIndexAction{
+						IndexName:      aws.String(idxName),
+						WarmThroughput: newWarmThroughput,
 					},
 				})
 			}
@@ -1981,6 +2258,20 @@ func enrichReplicas(ctx context.Context, conn *dynamodb.Client, arn, tableName s
 	return tfList, nil
 }
 
+func addReplicaConsistencyModes(mode awstypes.MultiRegionConsistency, replicas []interface{}) []interface{} {
+	if mode == "" {
+		mode = awstypes.MultiRegionConsistencyEventual
+	}
+
+	for i, replicaRaw := range replicas {
+		replica := replicaRaw.(map[string]interface{})
+		replica["consistency_mode"] = string(mode)
+		replicas[i] = replica
+	}
+
+	return replicas
+}
+
 func addReplicaTagPropagates(configReplicas *schema.Set, replicas []interface{}) []interface{} {
 	if configReplicas.Len() == 0 {
 		return replicas
@@ -2036,6 +2327,32 @@ func clearSSEDefaultKey(ctx context.Context, client *conns.AWSClient, sseList []
 
 // clearReplicaDefaultKeys sets a replica's kms_key_arn to "" if it is the default key alias/aws/dynamodb for
 // the replica's region. Not clearing the key causes diff problems and sends the key to AWS when it should not be.
+// clearGSIDefaultWarmThroughputs removes the warm throughput of indexes that do not configure it.
+// Every index reports its warm throughput, even if only the default is in effect.
+func clearGSIDefaultWarmThroughputs(configGSIs *schema.Set, gsis []interface{}) []interface{} {
+	configured := make(map[string]bool)
+	for _, configGSIRaw := range configGSIs.List() {
+		configGSI := configGSIRaw.(map[string]interface{})
+
+		if v, ok := configGSI["warm_throughput"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			configured[configGSI[names.AttrName].(string)] = true
+		}
+	}
+
+	for i, gsiRaw := range gsis {
+		gsi := gsiRaw.(map[string]interface{})
+
+		if v, ok := gsi[names.AttrName].(string); ok && configured[v] {
+			continue
+		}
+
+		delete(gsi, "warm_throughput")
+		gsis[i] = gsi
+	}
+
+	return gsis
+}
+
 func clearReplicaDefaultKeys(ctx context.Context, client *conns.AWSClient, replicas []interface{}) []interface{} {
 	if len(replicas) == 0 {
 		return replicas
@@ -2152,6 +2469,13 @@ func flattenTableGlobalSecondaryIndex(gsi []awstypes.GlobalSecondaryIndexDescrip
 			gsi["on_demand_throughput"] = flattenOnDemandThroughput(g.OnDemandThroughput)
 		}
 
+		if v := g.WarmThroughput; v != nil {
+			gsi["warm_throughput"] = []interface{}{map[string]interface{}{
+				"read_units_per_second":  aws.ToInt64(v.ReadUnitsPerSecond),
+				"write_units_per_second": aws.ToInt64(v.WriteUnitsPerSecond),
+			}}
+		}
+
 		output = append(output, gsi)
 	}
 
@@ -2201,6 +2525,24 @@ func flattenOnDemandThroughput(apiObject *awstypes.OnDemandThroughput) []interfa
 	return []interface{}{m}
 }
 
+func flattenTableWarmThroughput(apiObject *awstypes.TableWarmThroughputDescription) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{}
+
+	if v := apiObject.ReadUnitsPerSecond; v != nil {
+		m["read_units_per_second"] = aws.ToInt64(v)
+	}
+
+	if v := apiObject.WriteUnitsPerSecond; v != nil {
+		m["write_units_per_second"] = aws.ToInt64(v)
+	}
+
+	return []interface{}{m}
+}
+
 func flattenReplicaDescription(apiObject *awstypes.ReplicaDescription) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -2328,6 +2670,10 @@ func expandGlobalSecondaryIndex(data map[string]interface{}, billingMode awstype
 		output.OnDemandThroughput = expandOnDemandThroughput(v[0].(map[string]any))
 	}
 
+	if v, ok := data["warm_throughput"].([]any); ok && len(v) > 0 && v[0] != nil {
+		output.WarmThroughput = expandWarmThroughput(v[0].(map[string]any))
+	}
+
 	return &output
 }
 
@@ -2455,6 +2801,24 @@ func expandOnDemandThroughput(tfMap map[string]interface{}) *awstypes.OnDemandTh
 	return apiObject
 }
 
+func expandWarmThroughput(tfMap map[string]interface{}) *awstypes.WarmThroughput {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &awstypes.WarmThroughput{}
+
+	if v, ok := tfMap["read_units_per_second"].(int); ok && v != 0 {
+		apiObject.ReadUnitsPerSecond = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["write_units_per_second"].(int); ok && v != 0 {
+		apiObject.WriteUnitsPerSecond = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
 func expandS3BucketSource(data map[string]interface{}) *awstypes.S3BucketSource {
 	if data == nil {
 		return nil
@@ -21,6 +21,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -315,6 +316,104 @@ func TestUpdateDiffGSI(t *testing.T) {
 				},
 			},
 		},
+
+		{ // Increase of warm throughput
+			Old: []interface{}{
+				map[string]interface{}{
+					names.AttrName:    "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"warm_throughput": []interface{}{map[string]interface{}{
+						"read_units_per_second":  12000,
+						"write_units_per_second": 4000,
+					}},
+				},
+			},
+			New: []interface{}{
+				map[string]interface{}{
+					names.AttrName:    "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"warm_throughput": []interface{}{map[string]interface{}{
+						"read_units_per_second":  15000,
+						"write_units_per_second": 5000,
+					}},
+				},
+			},
+			ExpectedUpdates: []awstypes.GlobalSecondaryIndexUpdate{
+				{
+					Update: &awstypes.UpdateGlobalSecondaryIndexAction{
+						IndexName: aws.String("att1-index"),
+						WarmThroughput: &awstypes.WarmThroughput{
+							ReadUnitsPerSecond:  aws.Int64(15000),
+							WriteUnitsPerSecond: aws.Int64(5000),
+						},
+					},
+				},
+			},
+		},
+
+		{ // Decrease of warm throughput
+			Old: []interface{}{
+				map[string]interface{}{
+					names.AttrName:    "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"warm_throughput": []interface{}{map[string]interface{}{
+						"read_units_per_second":  15000,
+						"write_units_per_second": 5000,
+					}},
+				},
+			},
+			New: []interface{}{
+				map[string]interface{}{
+					names.AttrName:    "att1-index",
+					"hash_key":        "att1",
+					"write_capacity":  10,
+					"read_capacity":   10,
+					"projection_type": "ALL",
+					"warm_throughput": []interface{}{map[string]interface{}{
+						"read_units_per_second":  12000,
+						"write_units_per_second": 5000,
+					}},
+				},
+			},
+			ExpectedUpdates: []awstypes.GlobalSecondaryIndexUpdate{
+				{
+					Delete: &awstypes.DeleteGlobalSecondaryIndexAction{
+						IndexName: aws.String("att1-index"),
+					},
+				},
+				{
+					Create: &awstypes.CreateGlobalSecondaryIndexAction{
+						IndexName: aws.String("att1-index"),
+						KeySchema: []awstypes.KeySchemaElement{
+							{
+								AttributeName: aws.String("att1"),
+								KeyType:       awstypes.KeyTypeHash,
+							},
+						},
+						ProvisionedThroughput: &awstypes.ProvisionedThroughput{
+							WriteCapacityUnits: aws.Int64(10),
+							ReadCapacityUnits:  aws.Int64(10),
+						},
+						Projection: &awstypes.Projection{
+							ProjectionType: awstypes.ProjectionTypeAll,
+						},
+						WarmThroughput: &awstypes.WarmThroughput{
+							ReadUnitsPerSecond:  aws.Int64(12000),
+							WriteUnitsPerSecond: aws.Int64(5000),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
@@ -1003,6 +1102,98 @@ func TestAccDynamoDBTable_gsiOnDemandThroughput(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_warmThroughput(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_warmThroughput(rName, 12100, 4100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.read_units_per_second", "12100"),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.write_units_per_second", "4100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableConfig_warmThroughput(rName, 13000, 4500),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.read_units_per_second", "13000"),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.write_units_per_second", "4500"),
+				),
+			},
+			{
+				Config: testAccTableConfig_warmThroughput(rName, 12100, 4500),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "warm_throughput.0.read_units_per_second", "12100"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTable_gsiWarmThroughput(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_gsiWarmThroughput(rName, 12100, 4100),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"warm_throughput.0.read_units_per_second":  "12100",
+						"warm_throughput.0.write_units_per_second": "4100",
+					}),
+				),
+			},
+			{
+				Config: testAccTableConfig_gsiWarmThroughput(rName, 13000, 4500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &conf),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "global_secondary_index.*", map[string]string{
+						"warm_throughput.0.read_units_per_second":  "13000",
+						"warm_throughput.0.write_units_per_second": "4500",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_streamSpecification(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf awstypes.TableDescription
@@ -1969,6 +2160,53 @@ func TestAccDynamoDBTable_Replica_multiple(t *testing.T) {
 	})
 }
 
+func TestAccDynamoDBTable_Replica_multipleStrongConsistency(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var table awstypes.TableDescription
+	resourceName := "aws_dynamodb_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 3)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 3),
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTableConfig_replicaConsistencyMode(rName, string(awstypes.MultiRegionConsistencyStrong), string(awstypes.MultiRegionConsistencyEventual)),
+				ExpectError: regexache.MustCompile(`all replicas must have the same consistency_mode`),
+			},
+			{
+				Config: testAccTableConfig_replicaConsistencyMode(rName, string(awstypes.MultiRegionConsistencyStrong), string(awstypes.MultiRegionConsistencyStrong)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInitialTableExists(ctx, resourceName, &table),
+					resource.TestCheckResourceAttr(resourceName, "replica.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						"consistency_mode": string(awstypes.MultiRegionConsistencyStrong),
+					}),
+				),
+			},
+			{
+				Config:            testAccTableConfig_replicaConsistencyMode(rName, string(awstypes.MultiRegionConsistencyStrong), string(awstypes.MultiRegionConsistencyStrong)),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccTableConfig_replicaConsistencyMode(rName, string(awstypes.MultiRegionConsistencyEventual), string(awstypes.MultiRegionConsistencyEventual)),
+				ExpectError: regexache.MustCompile(`replica consistency_mode cannot be changed`),
+			},
+		},
+	})
+}
+
 func TestAccDynamoDBTable_Replica_single(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -3535,6 +3773,57 @@ resource "aws_dynamodb_table" "test" {
 `, rName, read, write)
 }
 
+func testAccTableConfig_warmThroughput(rName string, read, write int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  warm_throughput {
+    read_units_per_second  = %[2]d
+    write_units_per_second = %[3]d
+  }
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+}
+`, rName, read, write)
+}
+
+func testAccTableConfig_gsiWarmThroughput(rName string, read, write int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "TestTableHashKey"
+
+  global_secondary_index {
+    name            = "att1-index"
+    hash_key        = "att1"
+    projection_type = "ALL"
+
+    warm_throughput {
+      read_units_per_second  = %[2]d
+      write_units_per_second = %[3]d
+    }
+  }
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  attribute {
+    name = "att1"
+    type = "S"
+  }
+}
+`, rName, read, write)
+}
+
 func testAccTableConfig_streamSpecification(rName string, enabled bool, viewType string) string {
 	if viewType != "null" {
 		viewType = fmt.Sprintf(`"%s"`, viewType)
@@ -4461,6 +4750,43 @@ resource "aws_dynamodb_table" "test" {
 `, rName))
 }
 
+func testAccTableConfig_replicaConsistencyMode(rName, consistencyMode1, consistencyMode2 string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigMultipleRegionProvider(3),
+		fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+data "aws_region" "third" {
+  provider = "awsthird"
+}
+
+resource "aws_dynamodb_table" "test" {
+  name             = %[1]q
+  hash_key         = "TestTableHashKey"
+  billing_mode     = "PAY_PER_REQUEST"
+  stream_enabled   = true
+  stream_view_type = "NEW_AND_OLD_IMAGES"
+
+  attribute {
+    name = "TestTableHashKey"
+    type = "S"
+  }
+
+  replica {
+    region_name      = data.aws_region.alternate.name
+    consistency_mode = %[2]q
+  }
+
+  replica {
+    region_name      = data.aws_region.third.name
+    consistency_mode = %[3]q
+  }
+}
+`, rName, consistencyMode1, consistencyMode2))
+}
+
 func testAccTableConfig_replicaTagsNext1(rName string, region1 string, propagate1 bool) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigMultipleRegionProvider(3),
@@ -39,6 +39,47 @@ func validStreamSpec(d *schema.ResourceDiff) error {
 	return nil
 }
 
+// validateReplicaConsistencyMode ensures that all replicas use the same multi-Region consistency mode and that the
+// consistency mode of an existing global table is not changed, as it can only be set when the global table is created.
+func validateReplicaConsistencyMode(d *schema.ResourceDiff) error {
+	o, n := d.GetChange("replica")
+	oldReplicas, newReplicas := o.(*schema.Set).List(), n.(*schema.Set).List()
+
+	if len(newReplicas) == 0 {
+		return nil
+	}
+
+	mode := replicaConsistencyMode(newReplicas)
+	regions := make(map[string]struct{})
+
+	for _, tfMapRaw := range newReplicas {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		if v := replicaConsistencyMode([]interface{}{tfMap}); v != mode {
+			return fmt.Errorf("all replicas must have the same consistency_mode, got %q and %q", mode, v)
+		}
+
+		regions[tfMap["region_name"].(string)] = struct{}{}
+	}
+
+	// The global table is only (re)created if none of its existing replicas are kept.
+	for _, tfMapRaw := range oldReplicas {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		if _, ok := regions[tfMap["region_name"].(string)]; !ok {
+			continue
+		}
+
+		if v := replicaConsistencyMode(oldReplicas); v != mode {
+			return fmt.Errorf("replica consistency_mode cannot be changed from %q to %q, it can only be set when the global table is created", v, mode)
+		}
+
+		break
+	}
+
+	return nil
+}
+
 // checkIfNonKeyAttributesChanged returns true if non_key_attributes between old map and new map are different
 func checkIfNonKeyAttributesChanged(oldMap, newMap map[string]interface{}) bool {
 	oldNonKeyAttributes, oldNkaExists := oldMap["non_key_attributes"].(*schema.Set)
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -1384,7 +1385,7 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	for _, deployment := range service.Deployments {
-		if aws.ToString(deployment.Status) == "PRIMARY" {
+		if aws.ToString(deployment.Status) == deploymentStatusPrimary {
 			if err := d.Set("vpc_lattice_configurations", flattenVPCLatticeConfigurations(deployment.VpcLatticeConfigurations)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "setting vpc_lattice_configurations: %s", err)
 			}
@@ -1827,6 +1828,91 @@ func (e *expectServiceActiveError) Error() string {
 	return fmt.Sprintf("expected status %[1]q, was %[2]q", serviceStatusActive, e.status)
 }
 
+type serviceDeploymentFailedError struct {
+	deploymentID       string
+	reason             string
+	stoppedTaskReasons []string
+}
+
+func newServiceDeploymentFailedError(deploymentID, reason string, stoppedTaskReasons []string) *serviceDeploymentFailedError {
+	return &serviceDeploymentFailedError{
+		deploymentID:       deploymentID,
+		reason:             reason,
+		stoppedTaskReasons: stoppedTaskReasons,
+	}
+}
+
+func (e *serviceDeploymentFailedError) Error() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "deployment (%s) failed", e.deploymentID)
+	if e.reason != "" {
+		fmt.Fprintf(&sb, ": %s", e.reason)
+	}
+
+	if len(e.stoppedTaskReasons) > 0 {
+		sb.WriteString("\nstopped tasks:")
+		for _, v := range e.stoppedTaskReasons {
+			fmt.Fprintf(&sb, "\n  - %s", v)
+		}
+	}
+
+	return sb.String()
+}
+
+// findServiceDeploymentStoppedTaskReasons returns the distinct reasons that tasks started by the specified deployment stopped.
+// Errors are logged and ignored as the reasons are only used to enrich diagnostics.
+func findServiceDeploymentStoppedTaskReasons(ctx context.Context, conn *ecs.Client, clusterNameOrARN, deploymentID string) []string {
+	const (
+		maxTasks = 10
+	)
+	listInput := &ecs.ListTasksInput{
+		Cluster:       aws.String(clusterNameOrARN),
+		DesiredStatus: awstypes.DesiredStatusStopped,
+		MaxResults:    aws.Int32(maxTasks),
+		StartedBy:     aws.String(deploymentID),
+	}
+
+	listOutput, err := conn.ListTasks(ctx, listInput)
+
+	if err != nil {
+		log.Printf("[WARN] listing ECS Service deployment (%s) stopped tasks: %s", deploymentID, err)
+		return nil
+	}
+
+	if len(listOutput.TaskArns) == 0 {
+		return nil
+	}
+
+	describeOutput, err := conn.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+		Cluster: aws.String(clusterNameOrARN),
+		Tasks:   listOutput.TaskArns,
+	})
+
+	if err != nil {
+		log.Printf("[WARN] describing ECS Service deployment (%s) stopped tasks: %s", deploymentID, err)
+		return nil
+	}
+
+	var reasons []string
+
+	for _, task := range describeOutput.Tasks {
+		reason := aws.ToString(task.StoppedReason)
+
+		for _, container := range task.Containers {
+			if v := aws.ToString(container.Reason); v != "" {
+				reason = fmt.Sprintf("%s (container %s: %s)", reason, aws.ToString(container.Name), v)
+			}
+		}
+
+		if reason != "" && !slices.Contains(reasons, reason) {
+			reasons = append(reasons, reason)
+		}
+	}
+
+	return reasons
+}
+
 func findServiceByTwoPartKeyWaitForActive(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string) (*awstypes.Service, error) {
 	var service *awstypes.Service
 
@@ -1872,6 +1958,8 @@ const (
 	serviceStatusActive   = "ACTIVE"
 	serviceStatusDraining = "DRAINING"
 
+	deploymentStatusPrimary = "PRIMARY"
+
 	// Non-standard statuses for statusServiceWaitForStable().
 	serviceStatusPending = "tfPENDING"
 	serviceStatusStable  = "tfSTABLE"
@@ -1894,6 +1982,9 @@ func statusService(ctx context.Context, conn *ecs.Client, serviceName, clusterNa
 }
 
 func statusServiceWaitForStable(ctx context.Context, conn *ecs.Client, serviceName, clusterNameOrARN string) retry.StateRefreshFunc {
+	// The deployment that was primary when waiting started, i.e. the one being waited on.
+	var deploymentID string
+
 	return func() (interface{}, string, error) {
 		outputRaw, status, err := statusService(ctx, conn, serviceName, clusterNameOrARN)()
 
@@ -1907,6 +1998,20 @@ func statusServiceWaitForStable(ctx context.Context, conn *ecs.Client, serviceNa
 
 		output := outputRaw.(*awstypes.Service)
 
+		for _, v := range output.Deployments {
+			id := aws.ToString(v.Id)
+
+			if deploymentID == "" && aws.ToString(v.Status) == deploymentStatusPrimary {
+				deploymentID = id
+			}
+
+			// The deployment circuit breaker marks a deployment as failed, and optionally starts a rollback.
+			// Fail fast instead of waiting for the timeout, or for the rollback to complete.
+			if id == deploymentID && v.RolloutState == awstypes.DeploymentRolloutStateFailed {
+				return output, "", newServiceDeploymentFailedError(id, aws.ToString(v.RolloutStateReason), findServiceDeploymentStoppedTaskReasons(ctx, conn, clusterNameOrARN, id))
+			}
+		}
+
 		if n, dc, rc := len(output.Deployments), output.DesiredCount, output.RunningCount; n == 1 && dc == rc {
 			status = serviceStatusStable
 		} else {
@@ -84,6 +84,39 @@ func TestClustereNameFromARN(t *testing.T) {
 	}
 }
 
+func TestServiceDeploymentFailedError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name               string
+		reason             string
+		stoppedTaskReasons []string
+		want               string
+	}{
+		{"no reasons", "", nil, "deployment (ecs-svc/1234) failed"},
+		{
+			"reason",
+			"ECS deployment circuit breaker: tasks failed to start.",
+			nil,
+			"deployment (ecs-svc/1234) failed: ECS deployment circuit breaker: tasks failed to start.",
+		},
+		{
+			"stopped task reasons",
+			"ECS deployment circuit breaker: tasks failed to start.",
+			[]string{"Essential container in task exited", "CannotPullContainerError: pull image manifest has been retried 5 time(s)"},
+			"deployment (ecs-svc/1234) failed: ECS deployment circuit breaker: tasks failed to start.\nstopped tasks:\n  - Essential container in task exited\n  - CannotPullContainerError: pull image manifest has been retried 5 time(s)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tfecs.NewServiceDeploymentFailedError("ecs-svc/1234", tt.reason, tt.stoppedTaskReasons).Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestAccECSService_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service awstypes.Service
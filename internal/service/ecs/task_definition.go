@@ -18,6 +18,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -59,12 +60,16 @@ func resourceTaskDefinition() *schema.Resource {
 					return nil, idErr
 				}
 				d.SetId(familyRevisionParts[0])
+				d.Set("skip_revision_on_tag_change", true)
 
 				return []*schema.ResourceData{d}, nil
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			taskDefinitionTagsForceNew,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  resourceTaskDefinitionMigrateState,
@@ -281,6 +286,11 @@ func resourceTaskDefinition() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+			"skip_revision_on_tag_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"task_role_arn": {
@@ -621,6 +631,7 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "setting runtime_platform: %s", err)
 	}
 	d.Set("task_role_arn", taskDefinition.TaskRoleArn)
+	d.Set("skip_revision_on_tag_change", d.Get("skip_revision_on_tag_change"))
 	d.Set("track_latest", d.Get("track_latest"))
 	if err := d.Set("volume", flattenVolumes(taskDefinition.Volumes)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting volume: %s", err)
@@ -647,7 +658,7 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 func resourceTaskDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	// Tags only.
+	// Tags and non-refreshable attributes only.
 
 	return append(diags, resourceTaskDefinitionRead(ctx, d, meta)...)
 }
@@ -673,6 +684,20 @@ func resourceTaskDefinitionDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// taskDefinitionTagsForceNew registers a new revision on tag changes if skip_revision_on_tag_change is false.
+// By default, tags are updated in-place on the existing revision.
+func taskDefinitionTagsForceNew(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.Get("skip_revision_on_tag_change").(bool) {
+		return nil
+	}
+
+	if d.HasChange(names.AttrTagsAll) {
+		return d.ForceNew(names.AttrTagsAll)
+	}
+
+	return nil
+}
+
 func findTaskDefinition(ctx context.Context, conn *ecs.Client, input *ecs.DescribeTaskDefinitionInput) (*awstypes.TaskDefinition, []awstypes.Tag, error) {
 	output, err := conn.DescribeTaskDefinition(ctx, input)
 	if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusBadRequest) {
@@ -1788,5 +1788,16 @@ func validateAutoModeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _
 		}
 	}
 
+	// EKS Auto Mode grants its nodes cluster access through access entries.
+	if d.HasChanges("access_config", "compute_config") {
+		computeConfig := expandComputeConfigRequest(d.Get("compute_config").([]interface{}))
+
+		if computeConfig != nil && aws.ToBool(computeConfig.Enabled) && d.NewValueKnown("access_config.0.authentication_mode") {
+			if v := d.Get("access_config.0.authentication_mode").(string); v == string(types.AuthenticationModeConfigMap) {
+				return fmt.Errorf("access_config.authentication_mode must be %q or %q when compute_config.enabled is true", types.AuthenticationModeApi, types.AuthenticationModeApiAndConfigMap)
+			}
+		}
+	}
+
 	return nil
 }
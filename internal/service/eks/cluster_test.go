@@ -290,6 +290,25 @@ func TestAccEKSCluster_BootstrapSelfManagedAddons_migrate(t *testing.T) {
 	})
 }
 
+func TestAccEKSCluster_ComputeConfig_authenticationModeConfigMap(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_computeConfigAuthenticationMode(rName, "CONFIG_MAP"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`access_config.authentication_mode must be "API" or "API_AND_CONFIG_MAP"`),
+			},
+		},
+	})
+}
+
 func TestAccEKSCluster_ComputeConfig_OnCreate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster1, cluster2, cluster3 types.Cluster
@@ -1648,6 +1667,43 @@ resource "aws_eks_cluster" "test" {
 `, rName, enabled, role))
 }
 
+func testAccClusterConfig_computeConfigAuthenticationMode(rName, authenticationMode string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_computeConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.cluster.arn
+
+  access_config {
+    authentication_mode = %[2]q
+  }
+
+  bootstrap_self_managed_addons = false
+
+  compute_config {
+    enabled       = true
+    node_pools    = ["general-purpose"]
+    node_role_arn = aws_iam_role.node.arn
+  }
+
+  kubernetes_network_config {
+    elastic_load_balancing {
+      enabled = true
+    }
+  }
+
+  storage_config {
+    block_storage {
+      enabled = true
+    }
+  }
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+}
+`, rName, authenticationMode))
+}
+
 func testAccClusterConfig_computeConfig_onUpdateSetup(rName string) string {
 	return acctest.ConfigCompose(testAccClusterConfig_computeConfigBase(rName), fmt.Sprintf(`
 resource "aws_eks_cluster" "test" {
@@ -13,6 +13,7 @@ var (
 	ResourceIdentityProviderConfig  = resourceIdentityProviderConfig
 	ResourceNodeGroup               = resourceNodeGroup
 	ResourcePodIdentityAssociation  = newPodIdentityAssociationResource
+	ResourcePodIdentityAssociations = newPodIdentityAssociationsResource
 
 	ClusterStateUpgradeV0                      = clusterStateUpgradeV0
 	FindAccessEntryByTwoPartKey                = findAccessEntryByTwoPartKey
@@ -23,4 +24,5 @@ var (
 	FindNodegroupByTwoPartKey                  = findNodegroupByTwoPartKey
 	FindOIDCIdentityProviderConfigByTwoPartKey = findOIDCIdentityProviderConfigByTwoPartKey
 	FindPodIdentityAssociationByTwoPartKey     = findPodIdentityAssociationByTwoPartKey
+	FindPodIdentityAssociationsByClusterName   = findPodIdentityAssociationsByClusterName
 )
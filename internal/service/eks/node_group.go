@@ -13,6 +13,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -503,7 +506,9 @@ func resourceNodeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		updateID := aws.ToString(output.Update.Id)
 
-		if _, err := waitNodegroupUpdateSuccessful(ctx, conn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		autoscalingConn := meta.(*conns.AWSClient).AutoScalingClient(ctx)
+
+		if _, err := waitNodegroupVersionUpdateSuccessful(ctx, conn, autoscalingConn, clusterName, nodeGroupName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EKS Node Group (%s) version update (%s): %s", d.Id(), updateID, err)
 		}
 	}
@@ -664,6 +669,35 @@ func statusNodegroupUpdate(ctx context.Context, conn *eks.Client, clusterName, n
 	}
 }
 
+// statusNodegroupVersionUpdate is statusNodegroupUpdate for version updates, logging how many
+// of the node group's instances have been replaced while the update is in progress.
+func statusNodegroupVersionUpdate(ctx context.Context, conn *eks.Client, autoscalingConn *autoscaling.Client, clusterName, nodeGroupName, id string, progress *nodegroupUpdateProgress) retry.StateRefreshFunc {
+	refresh := statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id)
+
+	return func() (interface{}, string, error) {
+		output, status, err := refresh()
+
+		if err != nil || status != string(types.UpdateStatusInProgress) {
+			return output, status, err
+		}
+
+		v, err := findNodegroupUpdateProgress(ctx, conn, autoscalingConn, clusterName, nodeGroupName)
+
+		if err != nil {
+			log.Printf("[WARN] reading EKS Node Group (%s) update progress: %s", NodeGroupCreateResourceID(clusterName, nodeGroupName), err)
+
+			return output, status, nil
+		}
+
+		if *progress != *v {
+			log.Printf("[INFO] EKS Node Group (%s) update (%s) in progress: %s", NodeGroupCreateResourceID(clusterName, nodeGroupName), id, v)
+			*progress = *v
+		}
+
+		return output, status, nil
+	}
+}
+
 func waitNodegroupCreated(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName string, timeout time.Duration) (*types.Nodegroup, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(types.NodegroupStatusCreating),
@@ -727,6 +761,84 @@ func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, cluste
 	return nil, err
 }
 
+func waitNodegroupVersionUpdateSuccessful(ctx context.Context, conn *eks.Client, autoscalingConn *autoscaling.Client, clusterName, nodeGroupName, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
+	var progress nodegroupUpdateProgress
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.UpdateStatusInProgress),
+		Target:  enum.Slice(types.UpdateStatusSuccessful),
+		Refresh: statusNodegroupVersionUpdate(ctx, conn, autoscalingConn, clusterName, nodeGroupName, id, &progress),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.Update); ok {
+		switch status := output.Status; status {
+		case types.UpdateStatusCancelled, types.UpdateStatusFailed:
+			tfresource.SetLastError(err, errorDetailsError(output.Errors))
+		case types.UpdateStatusInProgress:
+			if progress.total > 0 {
+				tfresource.SetLastError(err, errors.New(progress.String()))
+			}
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// nodegroupUpdateProgress counts the instances in a node group's Auto Scaling groups
+// that run the launch template version the groups are currently configured with.
+type nodegroupUpdateProgress struct {
+	total   int
+	updated int
+}
+
+func (p *nodegroupUpdateProgress) String() string {
+	return fmt.Sprintf("%d of %d nodes updated", p.updated, p.total)
+}
+
+func findNodegroupUpdateProgress(ctx context.Context, conn *eks.Client, autoscalingConn *autoscaling.Client, clusterName, nodeGroupName string) (*nodegroupUpdateProgress, error) {
+	nodeGroup, err := findNodegroupByTwoPartKey(ctx, conn, clusterName, nodeGroupName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &nodegroupUpdateProgress{}
+
+	if nodeGroup.Resources == nil {
+		return progress, nil
+	}
+
+	for _, v := range nodeGroup.Resources.AutoScalingGroups {
+		group, err := tfautoscaling.FindGroupByName(ctx, autoscalingConn, aws.ToString(v.Name))
+
+		if err != nil {
+			return nil, err
+		}
+
+		var version string
+		switch {
+		case group.LaunchTemplate != nil:
+			version = aws.ToString(group.LaunchTemplate.Version)
+		case group.MixedInstancesPolicy != nil && group.MixedInstancesPolicy.LaunchTemplate != nil && group.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification != nil:
+			version = aws.ToString(group.MixedInstancesPolicy.LaunchTemplate.LaunchTemplateSpecification.Version)
+		}
+
+		for _, instance := range group.Instances {
+			progress.total++
+
+			if instance.LaunchTemplate != nil && aws.ToString(instance.LaunchTemplate.Version) == version && instance.LifecycleState == autoscalingtypes.LifecycleStateInService {
+				progress.updated++
+			}
+		}
+	}
+
+	return progress, nil
+}
+
 func issueError(apiObject types.Issue) error {
 	return fmt.Errorf("%s: %s", apiObject.Code, aws.ToString(apiObject.Message))
 }
@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Pod Identity Associations")
+func newPodIdentityAssociationsResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &podIdentityAssociationsResource{}
+
+	return r, nil
+}
+
+const (
+	ResNamePodIdentityAssociations = "Pod Identity Associations"
+)
+
+type podIdentityAssociationsResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *podIdentityAssociationsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_eks_pod_identity_associations"
+}
+
+func (r *podIdentityAssociationsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			"association": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[podIdentityAssociationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrNamespace: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"service_account": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *podIdentityAssociationsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan podIdentityAssociationsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := plan.Associations.ToSlice(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EKSClient(ctx)
+
+	if err := syncPodIdentityAssociations(ctx, conn, plan.ClusterName.ValueString(), want); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionCreating, ResNamePodIdentityAssociations, plan.ClusterName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set values for unknowns.
+	plan.setID()
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *podIdentityAssociationsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EKSClient(ctx)
+
+	var data podIdentityAssociationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findPodIdentityAssociationsByClusterName(ctx, conn, data.ClusterName.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionReading, ResNamePodIdentityAssociations, data.ClusterName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	associations := make([]*podIdentityAssociationModel, 0, len(output))
+	for _, v := range output {
+		associations = append(associations, &podIdentityAssociationModel{
+			Namespace:      fwflex.StringToFramework(ctx, v.Namespace),
+			RoleARN:        fwtypes.ARNValue(aws.ToString(v.RoleArn)),
+			ServiceAccount: fwflex.StringToFramework(ctx, v.ServiceAccount),
+		})
+	}
+
+	data.Associations = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, associations)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *podIdentityAssociationsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var old, new podIdentityAssociationsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &old)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &new)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !new.Associations.Equal(old.Associations) {
+		want, diags := new.Associations.ToSlice(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		conn := r.Meta().EKSClient(ctx)
+
+		if err := syncPodIdentityAssociations(ctx, conn, new.ClusterName.ValueString(), want); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EKS, create.ErrActionUpdating, ResNamePodIdentityAssociations, new.ClusterName.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &new)...)
+}
+
+func (r *podIdentityAssociationsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state podIdentityAssociationsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EKSClient(ctx)
+
+	if err := syncPodIdentityAssociations(ctx, conn, state.ClusterName.ValueString(), nil); err != nil {
+		if tfresource.NotFound(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionDeleting, ResNamePodIdentityAssociations, state.ClusterName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *podIdentityAssociationsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrClusterName), req.ID)...)
+}
+
+// syncPodIdentityAssociations makes the cluster's Pod Identity associations match want.
+//
+// Associations are keyed by namespace and service account, as EKS allows at most one
+// association per service account. Configured associations that do not exist are created,
+// those with a different role are updated and any others are deleted.
+// Associations owned by EKS add-ons are left alone.
+func syncPodIdentityAssociations(ctx context.Context, conn *eks.Client, clusterName string, want []*podIdentityAssociationModel) error {
+	have, err := findPodIdentityAssociationsByClusterName(ctx, conn, clusterName)
+
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]awstypes.PodIdentityAssociation, len(have))
+	for _, v := range have {
+		existing[podIdentityAssociationKey(aws.ToString(v.Namespace), aws.ToString(v.ServiceAccount))] = v
+	}
+
+	for _, v := range want {
+		namespace, serviceAccount, roleARN := v.Namespace.ValueString(), v.ServiceAccount.ValueString(), v.RoleARN.ValueString()
+		key := podIdentityAssociationKey(namespace, serviceAccount)
+
+		association, ok := existing[key]
+		delete(existing, key)
+
+		if !ok {
+			input := &eks.CreatePodIdentityAssociationInput{
+				ClientRequestToken: aws.String(sdkid.UniqueId()),
+				ClusterName:        aws.String(clusterName),
+				Namespace:          aws.String(namespace),
+				RoleArn:            aws.String(roleARN),
+				ServiceAccount:     aws.String(serviceAccount),
+			}
+
+			_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidParameterException](ctx, propagationTimeout, func() (interface{}, error) {
+				return conn.CreatePodIdentityAssociation(ctx, input)
+			}, "Role provided in the request does not exist")
+
+			if err != nil {
+				return fmt.Errorf("creating Pod Identity Association (%s): %w", key, err)
+			}
+
+			continue
+		}
+
+		if aws.ToString(association.RoleArn) != roleARN {
+			input := &eks.UpdatePodIdentityAssociationInput{
+				AssociationId:      association.AssociationId,
+				ClientRequestToken: aws.String(sdkid.UniqueId()),
+				ClusterName:        aws.String(clusterName),
+				RoleArn:            aws.String(roleARN),
+			}
+
+			_, err := tfresource.RetryWhenIsAErrorMessageContains[*awstypes.InvalidParameterException](ctx, propagationTimeout, func() (interface{}, error) {
+				return conn.UpdatePodIdentityAssociation(ctx, input)
+			}, "Role provided in the request does not exist")
+
+			if err != nil {
+				return fmt.Errorf("updating Pod Identity Association (%s): %w", key, err)
+			}
+		}
+	}
+
+	for key, association := range existing {
+		_, err := conn.DeletePodIdentityAssociation(ctx, &eks.DeletePodIdentityAssociationInput{
+			AssociationId: association.AssociationId,
+			ClusterName:   aws.String(clusterName),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting Pod Identity Association (%s): %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func podIdentityAssociationKey(namespace, serviceAccount string) string {
+	return namespace + "/" + serviceAccount
+}
+
+// findPodIdentityAssociationsByClusterName returns the cluster's Pod Identity associations
+// that are not owned by an EKS add-on.
+func findPodIdentityAssociationsByClusterName(ctx context.Context, conn *eks.Client, clusterName string) ([]awstypes.PodIdentityAssociation, error) {
+	input := &eks.ListPodIdentityAssociationsInput{
+		ClusterName: aws.String(clusterName),
+	}
+	var output []awstypes.PodIdentityAssociation
+
+	pages := eks.NewListPodIdentityAssociationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Associations {
+			if v.OwnerArn != nil {
+				continue
+			}
+
+			association, err := findPodIdentityAssociationByTwoPartKey(ctx, conn, aws.ToString(v.AssociationId), clusterName)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			output = append(output, *association)
+		}
+	}
+
+	return output, nil
+}
+
+type podIdentityAssociationsResourceModel struct {
+	Associations fwtypes.SetNestedObjectValueOf[podIdentityAssociationModel] `tfsdk:"association"`
+	ClusterName  types.String                                                `tfsdk:"cluster_name"`
+	ID           types.String                                                `tfsdk:"id"`
+}
+
+func (model *podIdentityAssociationsResourceModel) setID() {
+	model.ID = model.ClusterName
+}
+
+type podIdentityAssociationModel struct {
+	Namespace      types.String `tfsdk:"namespace"`
+	RoleARN        fwtypes.ARN  `tfsdk:"role_arn"`
+	ServiceAccount types.String `tfsdk:"service_account"`
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfeks "github.com/hashicorp/terraform-provider-aws/internal/service/eks"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSPodIdentityAssociations_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EKSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPodIdentityAssociationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrClusterName, "aws_eks_cluster.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "association.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "association.*", map[string]string{
+						names.AttrNamespace: rName,
+						"service_account":   rName + "-sa1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "association.*", map[string]string{
+						names.AttrNamespace: rName,
+						"service_account":   rName + "-sa2",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociations_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EKSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationsConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPodIdentityAssociationsExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfeks.ResourcePodIdentityAssociations, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEKSPodIdentityAssociations_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_pod_identity_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.EKSEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPodIdentityAssociationsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPodIdentityAssociationsConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPodIdentityAssociationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "association.#", "2"),
+				),
+			},
+			{
+				Config: testAccPodIdentityAssociationsConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPodIdentityAssociationsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "association.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "association.*", map[string]string{
+						names.AttrNamespace: rName,
+						"service_account":   rName + "-sa1",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "association.*.role_arn", "aws_iam_role.test2", names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "association.*", map[string]string{
+						names.AttrNamespace: rName,
+						"service_account":   rName + "-sa3",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPodIdentityAssociationsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eks_pod_identity_associations" {
+				continue
+			}
+
+			output, err := tfeks.FindPodIdentityAssociationsByClusterName(ctx, conn, rs.Primary.Attributes[names.AttrClusterName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("EKS Pod Identity Associations %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPodIdentityAssociationsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EKSClient(ctx)
+
+		output, err := tfeks.FindPodIdentityAssociationsByClusterName(ctx, conn, rs.Primary.Attributes[names.AttrClusterName])
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := strconv.Itoa(len(output)), rs.Primary.Attributes["association.#"]; got != want {
+			return fmt.Errorf("EKS Pod Identity Associations %s: got %s associations, want %s", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccPodIdentityAssociationsConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPodIdentityAssociationConfig_clusterBase(rName),
+		testAccPodIdentityAssociationConfig_podIdentityRoleBase(rName),
+		fmt.Sprintf(`
+resource "aws_eks_pod_identity_associations" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  association {
+    namespace       = %[1]q
+    service_account = "%[1]s-sa1"
+    role_arn        = aws_iam_role.test.arn
+  }
+
+  association {
+    namespace       = %[1]q
+    service_account = "%[1]s-sa2"
+    role_arn        = aws_iam_role.test.arn
+  }
+}
+`, rName))
+}
+
+func testAccPodIdentityAssociationsConfig_updated(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPodIdentityAssociationConfig_clusterBase(rName),
+		testAccPodIdentityAssociationConfig_podIdentityRoleBase(rName),
+		fmt.Sprintf(`
+resource "aws_iam_role" "test2" {
+  name = "%[1]s-2"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "pods.eks.amazonaws.com"
+      },
+      "Action": [
+        "sts:AssumeRole",
+        "sts:TagSession"
+      ]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_eks_pod_identity_associations" "test" {
+  cluster_name = aws_eks_cluster.test.name
+
+  association {
+    namespace       = %[1]q
+    service_account = "%[1]s-sa1"
+    role_arn        = aws_iam_role.test2.arn
+  }
+
+  association {
+    namespace       = %[1]q
+    service_account = "%[1]s-sa3"
+    role_arn        = aws_iam_role.test.arn
+  }
+}
+`, rName))
+}
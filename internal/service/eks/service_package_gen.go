@@ -27,6 +27,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "association_arn",
 			},
 		},
+		{
+			Factory: newPodIdentityAssociationsResource,
+			Name:    "Pod Identity Associations",
+		},
 	}
 }
 
@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,6 +24,11 @@ import (
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+const (
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length.
+	policyDocumentManagedPolicyMaxCharacters = 6144
+)
+
 // @SDKDataSource("aws_iam_policy_document", name="Policy Document")
 func dataSourcePolicyDocument() *schema.Resource {
 	return &schema.Resource{
@@ -60,6 +67,14 @@ func dataSourcePolicyDocument() *schema.Resource {
 			}
 
 			return map[string]*schema.Schema{
+				"character_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"deduplicate_statements": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
 				names.AttrJSON: {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -68,6 +83,10 @@ func dataSourcePolicyDocument() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"minify": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
 				// https://github.com/hashicorp/terraform-provider-aws/issues/31637.
 				"override_json": {
 					Type:         schema.TypeString,
@@ -149,6 +168,10 @@ func dataSourcePolicyDocument() *schema.Resource {
 						},
 					},
 				},
+				"validate_managed_policy_size": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
 				names.AttrVersion: {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -305,6 +328,14 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	if d.Get("deduplicate_statements").(bool) {
+		stmts, err := dataSourcePolicyDocumentDeduplicateStatements(mergedDoc.Statements)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: deduplicating statements: %s", err)
+		}
+		mergedDoc.Statements = stmts
+	}
+
 	jsonDoc, err := json.MarshalIndent(mergedDoc, "", "  ")
 	if err != nil {
 		// should never happen if the above code is correct
@@ -312,8 +343,6 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonString := string(jsonDoc)
 
-	d.Set(names.AttrJSON, jsonString)
-
 	jsonMinDoc, err := json.Marshal(mergedDoc)
 	if err != nil {
 		// should never happen if the above code is correct
@@ -321,6 +350,17 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	}
 	jsonMinString := string(jsonMinDoc)
 
+	characterCount := policyDocumentCharacterCount(jsonMinString)
+	if d.Get("validate_managed_policy_size").(bool) && characterCount > policyDocumentManagedPolicyMaxCharacters {
+		return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: document is %d characters, which exceeds the managed policy limit of %d characters. Reduce the size of the document or split it into multiple policies.", characterCount, policyDocumentManagedPolicyMaxCharacters)
+	}
+
+	if d.Get("minify").(bool) {
+		jsonString = jsonMinString
+	}
+
+	d.Set("character_count", characterCount)
+	d.Set(names.AttrJSON, jsonString)
 	d.Set("minified_json", jsonMinString)
 
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
@@ -328,6 +368,41 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// dataSourcePolicyDocumentDeduplicateStatements removes statements that are identical, apart from their Sids, to an earlier statement.
+func dataSourcePolicyDocumentDeduplicateStatements(in []*IAMPolicyStatement) ([]*IAMPolicyStatement, error) {
+	var out []*IAMPolicyStatement
+	seen := make(map[string]struct{})
+
+	for _, stmt := range in {
+		v := *stmt
+		v.Sid = ""
+		b, err := json.Marshal(&v)
+		if err != nil {
+			return nil, err
+		}
+
+		key := string(b)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, stmt)
+	}
+
+	return out, nil
+}
+
+// policyDocumentCharacterCount returns the number of characters in a policy document that count towards IAM quotas.
+// IAM doesn't count whitespace.
+func policyDocumentCharacterCount(document string) int {
+	return utf8.RuneCountInString(strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, document))
+}
+
 func dataSourcePolicyDocumentReplaceVarsInList(in interface{}, version string) (interface{}, error) {
 	switch v := in.(type) {
 	case string:
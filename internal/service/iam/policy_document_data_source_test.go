@@ -5,6 +5,7 @@ package iam_test
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/YakDriver/regexache"
@@ -297,6 +298,70 @@ func TestAccIAMPolicyDocumentDataSource_duplicateSid(t *testing.T) {
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_deduplicateStatements(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_deduplicateStatements,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", names.AttrJSON,
+						testAccPolicyDocumentDeduplicateStatementsExpectedJSON,
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_minify(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_minify,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", names.AttrJSON, testAccPolicyDocumentMinifyExpectedJSON),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "minified_json", testAccPolicyDocumentMinifyExpectedJSON),
+					resource.TestCheckResourceAttr("data.aws_iam_policy_document.test", "character_count", strconv.Itoa(len(testAccPolicyDocumentMinifyExpectedJSON))),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMPolicyDocumentDataSource_validateManagedPolicySize(t *testing.T) {
+	ctx := acctest.Context(t)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_validateManagedPolicySize(100),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrWith("data.aws_iam_policy_document.test", "character_count", func(value string) error {
+						if v, err := strconv.Atoi(value); err != nil || v <= 0 || v > 6144 {
+							return fmt.Errorf("unexpected character_count: %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_validateManagedPolicySize(300),
+				ExpectError: regexache.MustCompile(`exceeds the managed policy limit of 6144 characters`),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_sourcePolicyValidJSON(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource.ParallelTest(t, resource.TestCase{
@@ -1315,6 +1380,84 @@ var testAccPolicyDocumentDuplicateBlankSidExpectedJSON = `{
   ]
 }`
 
+const testAccPolicyDocumentDataSourceConfig_deduplicateStatements = `
+data "aws_iam_policy_document" "test" {
+  deduplicate_statements = true
+
+  source_policy_documents = [
+    jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Sid      = "Source"
+        Effect   = "Allow"
+        Action   = "s3:GetObject"
+        Resource = "*"
+      }]
+    })
+  ]
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+
+  statement {
+    actions   = ["s3:PutObject"]
+    resources = ["*"]
+  }
+
+  statement {
+    actions   = ["s3:PutObject"]
+    resources = ["*"]
+  }
+}
+`
+
+const testAccPolicyDocumentDeduplicateStatementsExpectedJSON = `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Source",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "*"
+    }
+  ]
+}`
+
+const testAccPolicyDocumentDataSourceConfig_minify = `
+data "aws_iam_policy_document" "test" {
+  minify = true
+
+  statement {
+    actions   = ["ec2:DescribeAccountAttributes"]
+    resources = ["*"]
+  }
+}
+`
+
+const testAccPolicyDocumentMinifyExpectedJSON = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:DescribeAccountAttributes","Resource":"*"}]}`
+
+func testAccPolicyDocumentDataSourceConfig_validateManagedPolicySize(n int) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  validate_managed_policy_size = true
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = [for i in range(%[1]d) : "arn:${data.aws_partition.current.partition}:s3:::example-bucket-${i}/*"]
+  }
+}
+
+data "aws_partition" "current" {}
+`, n)
+}
+
 const testAccPolicyDocumentDataSourceConfig_version20081017 = `
 data "aws_iam_policy_document" "test" {
   version = "2008-10-17"
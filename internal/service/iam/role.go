@@ -18,6 +18,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -175,6 +176,11 @@ func resourceRole() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"prevent_boundary_removal": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"unique_id": {
@@ -183,8 +189,31 @@ func resourceRole() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceRoleCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceRoleCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.Get("prevent_boundary_removal").(bool) || !d.NewValueKnown("permissions_boundary") {
+		return nil
+	}
+
+	o, n := d.GetChange("permissions_boundary")
+	oldBoundary, newBoundary := o.(string), n.(string)
+
+	switch {
+	case newBoundary == "" && oldBoundary != "":
+		return fmt.Errorf("removing the permissions boundary (%s) is not allowed while prevent_boundary_removal is true", oldBoundary)
+	case newBoundary == "":
+		return errors.New("permissions_boundary must be set while prevent_boundary_removal is true")
+	case d.Id() != "" && oldBoundary != "" && newBoundary != oldBoundary:
+		return fmt.Errorf("changing the permissions boundary from %s to %s is not allowed while prevent_boundary_removal is true", oldBoundary, newBoundary)
 	}
+
+	return nil
 }
 
 func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -516,6 +545,7 @@ func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interf
 
 func resourceRoleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	d.Set("force_detach_policies", false)
+	d.Set("prevent_boundary_removal", false)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -5,6 +5,7 @@ package iam
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -48,6 +50,11 @@ func (r *resourceRolePoliciesExclusive) Metadata(_ context.Context, req resource
 func (r *resourceRolePoliciesExclusive) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"detect_only": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 			"role_name": schema.StringAttribute{
 				Required: true,
 				PlanModifiers: []planmodifier.String{
@@ -78,6 +85,12 @@ func (r *resourceRolePoliciesExclusive) Create(ctx context.Context, req resource
 		return
 	}
 
+	// In detect-only mode out-of-band inline policies are only reported during planning.
+	if plan.DetectOnly.ValueBool() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
 	err := r.syncAttachments(ctx, plan.RoleName.ValueString(), policyNames)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -112,7 +125,15 @@ func (r *resourceRolePoliciesExclusive) Read(ctx context.Context, req resource.R
 		return
 	}
 
-	state.PolicyNames = flex.FlattenFrameworkStringValueSetLegacy(ctx, out)
+	if state.DetectOnly.IsNull() {
+		state.DetectOnly = types.BoolValue(false)
+	}
+
+	// In detect-only mode the configured policy names are kept so that out-of-band
+	// inline policies are reported by ModifyPlan instead of planned for removal.
+	if !state.DetectOnly.ValueBool() {
+		state.PolicyNames = flex.FlattenFrameworkStringValueSetLegacy(ctx, out)
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -124,7 +145,8 @@ func (r *resourceRolePoliciesExclusive) Update(ctx context.Context, req resource
 		return
 	}
 
-	if !plan.PolicyNames.Equal(state.PolicyNames) {
+	// Leaving detect-only mode removes any out-of-band inline policies.
+	if !plan.DetectOnly.ValueBool() && (!plan.PolicyNames.Equal(state.PolicyNames) || state.DetectOnly.ValueBool()) {
 		var policyNames []string
 		resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &policyNames, false)...)
 		if resp.Diagnostics.HasError() {
@@ -187,6 +209,54 @@ func (r *resourceRolePoliciesExclusive) syncAttachments(ctx context.Context, rol
 	return nil
 }
 
+func (r *resourceRolePoliciesExclusive) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// If the entire plan is null, the resource is planned for destruction.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan resourceRolePoliciesExclusiveData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DetectOnly.ValueBool() || plan.RoleName.IsUnknown() || plan.PolicyNames.IsUnknown() {
+		return
+	}
+
+	var want []string
+	resp.Diagnostics.Append(plan.PolicyNames.ElementsAs(ctx, &want, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := plan.RoleName.ValueString()
+	have, err := findRolePoliciesByName(ctx, conn, roleName)
+	if tfresource.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.IAM, create.ErrActionReading, ResNameRolePoliciesExclusive, plan.RoleName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	_, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+	for _, name := range remove {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("policy_names"),
+			"Unmanaged Inline Policy",
+			fmt.Sprintf("Inline policy %q is assigned to IAM role %q but is not configured in policy_names. "+
+				"It is not removed because detect_only is true, but will be removed once detect_only is false.", name, roleName),
+		)
+	}
+}
+
 func (r *resourceRolePoliciesExclusive) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("role_name"), req, resp)
 }
@@ -217,6 +287,7 @@ func findRolePoliciesByName(ctx context.Context, conn *iam.Client, roleName stri
 }
 
 type resourceRolePoliciesExclusiveData struct {
+	DetectOnly  types.Bool   `tfsdk:"detect_only"`
 	RoleName    types.String `tfsdk:"role_name"`
 	PolicyNames types.Set    `tfsdk:"policy_names"`
 }
@@ -255,6 +255,50 @@ func TestAccIAMRolePoliciesExclusive_outOfBandAddition(t *testing.T) {
 	})
 }
 
+func TestAccIAMRolePoliciesExclusive_detectOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var role types.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	policyName := rName + "-out-of-band"
+	resourceName := "aws_iam_role_policies_exclusive.test"
+	roleResourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePoliciesExclusiveConfig_detectOnly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, roleResourceName, &role),
+					testAccCheckRolePoliciesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "detect_only", acctest.CtTrue),
+					testAccCheckRolePolicyAddInlinePolicy(ctx, &role, policyName),
+				),
+			},
+			{
+				// The out-of-band inline policy is only reported.
+				Config: testAccRolePoliciesExclusiveConfig_detectOnly(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePoliciesExclusiveRolePolicyCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+				),
+			},
+			{
+				Config: testAccRolePoliciesExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePoliciesExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "detect_only", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "policy_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRolePoliciesExclusiveDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
@@ -307,6 +351,27 @@ func testAccCheckRolePoliciesExclusiveExists(ctx context.Context, name string) r
 	}
 }
 
+func testAccCheckRolePoliciesExclusiveRolePolicyCount(ctx context.Context, name string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+		out, err := tfiam.FindRolePoliciesByName(ctx, conn, rs.Primary.Attributes["role_name"])
+		if err != nil {
+			return err
+		}
+
+		if got := len(out); got != want {
+			return fmt.Errorf("IAM Role %s inline policies: got %d, want %d", rs.Primary.Attributes["role_name"], got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccRolePoliciesExclusiveConfigBase(rName string) string {
 	return fmt.Sprintf(`
 data "aws_iam_policy_document" "trust" {
@@ -350,6 +415,18 @@ resource "aws_iam_role_policies_exclusive" "test" {
 `)
 }
 
+func testAccRolePoliciesExclusiveConfig_detectOnly(rName string) string {
+	return acctest.ConfigCompose(
+		testAccRolePoliciesExclusiveConfigBase(rName),
+		`
+resource "aws_iam_role_policies_exclusive" "test" {
+  role_name    = aws_iam_role.test.name
+  policy_names = [aws_iam_role_policy.test.name]
+  detect_only  = true
+}
+`)
+}
+
 func testAccRolePoliciesExclusiveConfig_multiple(rName string) string {
 	return acctest.ConfigCompose(
 		testAccRolePoliciesExclusiveConfigBase(rName),
@@ -588,6 +588,56 @@ func TestAccIAMRole_permissionsBoundary(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_preventBoundaryRemoval(t *testing.T) {
+	ctx := acctest.Context(t)
+	var role awstypes.Role
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	permissionsBoundary1 := fmt.Sprintf("arn:%s:iam::aws:policy/AdministratorAccess", acctest.Partition())
+	permissionsBoundary2 := fmt.Sprintf("arn:%s:iam::aws:policy/ReadOnlyAccess", acctest.Partition())
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRoleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRoleConfig_preventBoundaryRemoval(rName, "", true),
+				ExpectError: regexache.MustCompile(`permissions_boundary must be set while prevent_boundary_removal is true`),
+			},
+			{
+				Config: testAccRoleConfig_preventBoundaryRemoval(rName, permissionsBoundary1, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &role),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary", permissionsBoundary1),
+					resource.TestCheckResourceAttr(resourceName, "prevent_boundary_removal", acctest.CtTrue),
+					testAccCheckRolePermissionsBoundary(&role, permissionsBoundary1),
+				),
+			},
+			{
+				Config:      testAccRoleConfig_preventBoundaryRemoval(rName, permissionsBoundary2, true),
+				ExpectError: regexache.MustCompile(`changing the permissions boundary from .+ is not allowed`),
+			},
+			{
+				Config:      testAccRoleConfig_preventBoundaryRemoval(rName, "", true),
+				ExpectError: regexache.MustCompile(`removing the permissions boundary \(.+\) is not allowed`),
+			},
+			{
+				Config: testAccRoleConfig_preventBoundaryRemoval(rName, "", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(ctx, resourceName, &role),
+					resource.TestCheckResourceAttr(resourceName, "permissions_boundary", ""),
+					resource.TestCheckResourceAttr(resourceName, "prevent_boundary_removal", acctest.CtFalse),
+					testAccCheckRolePermissionsBoundary(&role, ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMRole_InlinePolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var role awstypes.Role
@@ -1302,6 +1352,30 @@ resource "aws_iam_role" "test" {
 `, rName, permissionsBoundary)
 }
 
+func testAccRoleConfig_preventBoundaryRemoval(rName, permissionsBoundary string, preventBoundaryRemoval bool) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole",
+      Principal = {
+        Service = "ec2.${data.aws_partition.current.dns_suffix}",
+      }
+      Effect = "Allow"
+      Sid    = ""
+    }]
+  })
+
+  name                     = %[1]q
+  permissions_boundary     = %[2]q
+  prevent_boundary_removal = %[3]t
+}
+`, rName, permissionsBoundary, preventBoundaryRemoval)
+}
+
 func testAccRoleConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -5,36 +5,39 @@ package lambda
 
 // Exports for use in tests only.
 var (
-	ResourceAlias                        = resourceAlias
-	ResourceCodeSigningConfig            = resourceCodeSigningConfig
-	ResourceEventSourceMapping           = resourceEventSourceMapping
-	ResourceFunction                     = resourceFunction
-	ResourceFunctionEventInvokeConfig    = resourceFunctionEventInvokeConfig
-	ResourceFunctionURL                  = resourceFunctionURL
-	ResourceInvocation                   = resourceInvocation
-	ResourceLayerVersion                 = resourceLayerVersion
-	ResourceLayerVersionPermission       = resourceLayerVersionPermission
-	ResourcePermission                   = resourcePermission
-	ResourceProvisionedConcurrencyConfig = resourceProvisionedConcurrencyConfig
+	ResourceAlias                          = resourceAlias
+	ResourceCodeSigningConfig              = resourceCodeSigningConfig
+	ResourceEventSourceMapping             = resourceEventSourceMapping
+	ResourceFunction                       = resourceFunction
+	ResourceFunctionEventInvokeConfig      = resourceFunctionEventInvokeConfig
+	ResourceFunctionURL                    = resourceFunctionURL
+	ResourceInvocation                     = resourceInvocation
+	ResourceLayerVersion                   = resourceLayerVersion
+	ResourceLayerVersionPermission         = resourceLayerVersionPermission
+	ResourcePermission                     = resourcePermission
+	ResourceProvisionedConcurrencyConfig   = resourceProvisionedConcurrencyConfig
+	ResourceProvisionedConcurrencySchedule = resourceProvisionedConcurrencySchedule
 
-	FindAliasByTwoPartKey                        = findAliasByTwoPartKey
-	FindCodeSigningConfigByARN                   = findCodeSigningConfigByARN
-	FindEventSourceMappingByID                   = findEventSourceMappingByID
-	FindFunctionByName                           = findFunctionByName
-	FindFunctionEventInvokeConfigByTwoPartKey    = findFunctionEventInvokeConfigByTwoPartKey
-	FindFunctionRecursionConfigByName            = findFunctionRecursionConfigByName
-	FindFunctionURLByTwoPartKey                  = findFunctionURLByTwoPartKey
-	FindLayerVersionByTwoPartKey                 = findLayerVersionByTwoPartKey
-	FindLayerVersionPolicyByTwoPartKey           = findLayerVersionPolicyByTwoPartKey
-	FindPolicyStatementByTwoPartKey              = findPolicyStatementByTwoPartKey
-	FindProvisionedConcurrencyConfigByTwoPartKey = findProvisionedConcurrencyConfigByTwoPartKey
-	FindRuntimeManagementConfigByTwoPartKey      = findRuntimeManagementConfigByTwoPartKey
-	FunctionEventInvokeConfigParseResourceID     = functionEventInvokeConfigParseResourceID
-	GetFunctionNameFromARN                       = getFunctionNameFromARN
-	GetQualifierFromAliasOrVersionARN            = getQualifierFromAliasOrVersionARN
-	LayerVersionParseResourceID                  = layerVersionParseResourceID
-	LayerVersionPermissionParseResourceID        = layerVersionPermissionParseResourceID
-	SignerServiceIsAvailable                     = signerServiceIsAvailable
+	FindAliasByTwoPartKey                          = findAliasByTwoPartKey
+	FindCodeSigningConfigByARN                     = findCodeSigningConfigByARN
+	FindEventSourceMappingByID                     = findEventSourceMappingByID
+	FindFunctionByName                             = findFunctionByName
+	FindFunctionEventInvokeConfigByTwoPartKey      = findFunctionEventInvokeConfigByTwoPartKey
+	FindFunctionRecursionConfigByName              = findFunctionRecursionConfigByName
+	FindFunctionURLByTwoPartKey                    = findFunctionURLByTwoPartKey
+	FindLayerVersionByTwoPartKey                   = findLayerVersionByTwoPartKey
+	FindLayerVersionPolicyByTwoPartKey             = findLayerVersionPolicyByTwoPartKey
+	FindPolicyStatementByTwoPartKey                = findPolicyStatementByTwoPartKey
+	FindProvisionedConcurrencyConfigByTwoPartKey   = findProvisionedConcurrencyConfigByTwoPartKey
+	FindProvisionedConcurrencyScheduledActions     = findProvisionedConcurrencyScheduledActions
+	FindRuntimeManagementConfigByTwoPartKey        = findRuntimeManagementConfigByTwoPartKey
+	FunctionEventInvokeConfigParseResourceID       = functionEventInvokeConfigParseResourceID
+	GetFunctionNameFromARN                         = getFunctionNameFromARN
+	GetQualifierFromAliasOrVersionARN              = getQualifierFromAliasOrVersionARN
+	LayerVersionParseResourceID                    = layerVersionParseResourceID
+	LayerVersionPermissionParseResourceID          = layerVersionPermissionParseResourceID
+	ProvisionedConcurrencyScalableTargetResourceID = provisionedConcurrencyScalableTargetResourceID
+	SignerServiceIsAvailable                       = signerServiceIsAvailable
 
 	ValidFunctionName               = validFunctionName
 	ValidPermissionAction           = validPermissionAction
@@ -174,7 +174,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_push", "image_uri", names.AttrS3Bucket},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -210,10 +210,37 @@ func resourceFunction() *schema.Resource {
 					},
 				},
 			},
+			"image_push": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"registry_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						names.AttrRepositoryName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrSource: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"tag": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				Computed:     true,
+				ExactlyOneOf: []string{"filename", "image_push", "image_uri", names.AttrS3Bucket},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -308,6 +335,12 @@ func resourceFunction() *schema.Resource {
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				RequiredWith: []string{"replace_security_groups_on_destroy"},
 			},
+			"recursive_loop": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.RecursiveLoop](),
+			},
 			"reserved_concurrent_executions": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -327,7 +360,7 @@ func resourceFunction() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_push", "image_uri", names.AttrS3Bucket},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -338,7 +371,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_push", "image_uri"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -459,6 +492,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			customizeDiffImagePush,
 			updateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
 		),
@@ -496,6 +530,14 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 
 		input.Code.ZipFile = zipFile
+	} else if v, ok := d.GetOk("image_push"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		imageURI, err := pushImage(ctx, d, meta)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Code.ImageUri = aws.String(imageURI)
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
 	} else {
@@ -615,6 +657,17 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if v, ok := d.GetOk("recursive_loop"); ok {
+		_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+			FunctionName:  aws.String(d.Id()),
+			RecursiveLoop: awstypes.RecursiveLoop(v.(string)),
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) recursion config: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -720,6 +773,14 @@ func resourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "setting vpc_config: %s", err)
 	}
 
+	recursionConfig, err := findFunctionRecursionConfigByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) recursion config: %s", d.Id(), err)
+	}
+
+	d.Set("recursive_loop", recursionConfig.RecursiveLoop)
+
 	if hasQualifier {
 		d.Set("qualified_arn", functionARN)
 		d.Set("qualified_invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), functionARN))
@@ -962,6 +1023,14 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 
 			input.ZipFile = zipFile
+		} else if v, ok := d.GetOk("image_push"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			imageURI, err := pushImage(ctx, d, meta)
+
+			if err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+
+			input.ImageUri = aws.String(imageURI)
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
 		} else {
@@ -1012,6 +1081,19 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChange("recursive_loop") {
+		if v, ok := d.GetOk("recursive_loop"); ok {
+			_, err := conn.PutFunctionRecursionConfig(ctx, &lambda.PutFunctionRecursionConfigInput{
+				FunctionName:  aws.String(d.Id()),
+				RecursiveLoop: awstypes.RecursiveLoop(v.(string)),
+			})
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting Lambda Function (%s) recursion config: %s", d.Id(), err)
+			}
+		}
+	}
+
 	if d.Get("publish").(bool) && (codeUpdate || configUpdate || d.HasChange("publish")) {
 		input := &lambda.PublishVersionInput{
 			FunctionName: aws.String(d.Id()),
@@ -1357,6 +1439,7 @@ func needsFunctionCodeUpdate(d sdkv2.ResourceDiffer) bool {
 		d.HasChange(names.AttrS3Bucket) ||
 		d.HasChange("s3_key") ||
 		d.HasChange("s3_object_version") ||
+		d.HasChange("image_push") ||
 		d.HasChange("image_uri") ||
 		d.HasChange("architectures")
 }
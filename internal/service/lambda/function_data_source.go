@@ -171,6 +171,10 @@ func dataSourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"recursive_loop": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"reserved_concurrent_executions": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -333,6 +337,13 @@ func dataSourceFunctionRead(ctx context.Context, d *schema.ResourceData, meta in
 	d.Set("memory_size", function.MemorySize)
 	d.Set("qualified_arn", qualifiedARN)
 	d.Set("qualified_invoke_arn", invokeARN(ctx, meta.(*conns.AWSClient), qualifiedARN))
+	recursionConfig, err := findFunctionRecursionConfigByName(ctx, conn, functionName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s) recursion config: %s", functionName, err)
+	}
+
+	d.Set("recursive_loop", recursionConfig.RecursiveLoop)
 	if output.Concurrency != nil {
 		d.Set("reserved_concurrent_executions", output.Concurrency.ReservedConcurrentExecutions)
 	} else {
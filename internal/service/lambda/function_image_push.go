@@ -0,0 +1,343 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	ecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ociImageIndexMediaType      = "application/vnd.oci.image.index.v1+json"
+	ociImageManifestMediaType   = "application/vnd.oci.image.manifest.v1+json"
+	dockerManifestListMediaType = "application/vnd.docker.distribution.manifest.list.v2+json"
+	dockerManifestMediaType     = "application/vnd.docker.distribution.manifest.v2+json"
+
+	// See https://docs.aws.amazon.com/AmazonECR/latest/APIReference/API_BatchCheckLayerAvailability.html.
+	imageLayerAvailabilityMaxBatchSize = 100
+)
+
+var imageBlobDigestRegex = regexache.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
+type ociDescriptor struct {
+	Digest    string `json:"digest"`
+	MediaType string `json:"mediaType"`
+	Size      int64  `json:"size"`
+}
+
+type ociIndex struct {
+	Manifests []ociDescriptor `json:"manifests"`
+}
+
+type ociManifest struct {
+	Config    ociDescriptor   `json:"config"`
+	Layers    []ociDescriptor `json:"layers"`
+	MediaType string          `json:"mediaType"`
+}
+
+// imageLayout is a single container image read from an OCI image layout directory.
+// See https://github.com/opencontainers/image-spec/blob/main/image-layout.md.
+type imageLayout struct {
+	blobDigests       []string
+	dir               string
+	manifest          []byte
+	manifestDigest    string
+	manifestMediaType string
+}
+
+func readImageLayout(dir string) (*imageLayout, error) {
+	b, err := os.ReadFile(filepath.Join(dir, "index.json"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	var index ociIndex
+	if err := json.Unmarshal(b, &index); err != nil {
+		return nil, fmt.Errorf("parsing index.json: %w", err)
+	}
+
+	if n := len(index.Manifests); n != 1 {
+		return nil, fmt.Errorf("index.json must reference exactly one image manifest, found %d", n)
+	}
+
+	descriptor := index.Manifests[0]
+
+	switch descriptor.MediaType {
+	case ociImageManifestMediaType, dockerManifestMediaType:
+	case ociImageIndexMediaType, dockerManifestListMediaType:
+		return nil, fmt.Errorf("image %s is a multi-platform image index, build the image for a single platform", descriptor.Digest)
+	default:
+		return nil, fmt.Errorf("image %s has unsupported media type %q", descriptor.Digest, descriptor.MediaType)
+	}
+
+	path, err := imageBlobPath(dir, descriptor.Digest)
+
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if digest := imageBlobDigest(manifest); digest != descriptor.Digest {
+		return nil, fmt.Errorf("image manifest digest (%s) does not match index.json (%s)", digest, descriptor.Digest)
+	}
+
+	var m ociManifest
+	if err := json.Unmarshal(manifest, &m); err != nil {
+		return nil, fmt.Errorf("parsing image manifest %s: %w", descriptor.Digest, err)
+	}
+
+	layout := &imageLayout{
+		dir:               dir,
+		manifest:          manifest,
+		manifestDigest:    descriptor.Digest,
+		manifestMediaType: descriptor.MediaType,
+	}
+
+	for _, v := range append([]ociDescriptor{m.Config}, m.Layers...) {
+		if _, err := imageBlobPath(dir, v.Digest); err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(layout.blobDigests, v.Digest) {
+			layout.blobDigests = append(layout.blobDigests, v.Digest)
+		}
+	}
+
+	return layout, nil
+}
+
+func imageBlobPath(dir, digest string) (string, error) {
+	if !imageBlobDigestRegex.MatchString(digest) {
+		return "", fmt.Errorf("unsupported image blob digest %q", digest)
+	}
+
+	return filepath.Join(dir, "blobs", "sha256", digest[len("sha256:"):]), nil
+}
+
+func imageBlobDigest(b []byte) string {
+	h := sha256.Sum256(b)
+
+	return "sha256:" + hex.EncodeToString(h[:])
+}
+
+func imagePushURI(registryID, hostname, repositoryName, digest string) string {
+	return fmt.Sprintf("%s.%s/%s@%s", registryID, hostname, repositoryName, digest)
+}
+
+func expandImagePush(ctx context.Context, tfMap map[string]interface{}, meta interface{}) (string, string, string) {
+	registryID := meta.(*conns.AWSClient).AccountID(ctx)
+	if v, ok := tfMap["registry_id"].(string); ok && v != "" {
+		registryID = v
+	}
+
+	return registryID, tfMap[names.AttrRepositoryName].(string), tfMap["tag"].(string)
+}
+
+// pushImage pushes the image read from an image_push block to its ECR repository,
+// returning the digest-pinned image URI.
+func pushImage(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, error) {
+	tfMap := d.Get("image_push").([]interface{})[0].(map[string]interface{})
+	registryID, repositoryName, tag := expandImagePush(ctx, tfMap, meta)
+	source := tfMap[names.AttrSource].(string)
+
+	layout, err := readImageLayout(source)
+
+	if err != nil {
+		return "", fmt.Errorf("reading OCI image layout (%s): %w", source, err)
+	}
+
+	conn := meta.(*conns.AWSClient).ECRClient(ctx)
+
+	if err := pushImageLayout(ctx, conn, layout, registryID, repositoryName, tag); err != nil {
+		return "", fmt.Errorf("pushing image (%s) to ECR repository (%s): %w", layout.manifestDigest, repositoryName, err)
+	}
+
+	return imagePushURI(registryID, meta.(*conns.AWSClient).RegionalHostname(ctx, "dkr.ecr"), repositoryName, layout.manifestDigest), nil
+}
+
+func pushImageLayout(ctx context.Context, conn *ecr.Client, layout *imageLayout, registryID, repositoryName, tag string) error {
+	for chunk := range slices.Chunk(layout.blobDigests, imageLayerAvailabilityMaxBatchSize) {
+		input := &ecr.BatchCheckLayerAvailabilityInput{
+			LayerDigests:   chunk,
+			RegistryId:     aws.String(registryID),
+			RepositoryName: aws.String(repositoryName),
+		}
+
+		output, err := conn.BatchCheckLayerAvailability(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("checking layer availability: %w", err)
+		}
+
+		available := make(map[string]bool)
+		for _, v := range output.Layers {
+			if v.LayerAvailability == ecrtypes.LayerAvailabilityAvailable {
+				available[aws.ToString(v.LayerDigest)] = true
+			}
+		}
+
+		for _, digest := range chunk {
+			if available[digest] {
+				continue
+			}
+
+			if err := uploadImageBlob(ctx, conn, layout.dir, digest, registryID, repositoryName); err != nil {
+				return fmt.Errorf("uploading layer (%s): %w", digest, err)
+			}
+		}
+	}
+
+	input := &ecr.PutImageInput{
+		ImageDigest:            aws.String(layout.manifestDigest),
+		ImageManifest:          aws.String(string(layout.manifest)),
+		ImageManifestMediaType: aws.String(layout.manifestMediaType),
+		RegistryId:             aws.String(registryID),
+		RepositoryName:         aws.String(repositoryName),
+	}
+
+	if tag != "" {
+		input.ImageTag = aws.String(tag)
+	}
+
+	_, err := conn.PutImage(ctx, input)
+
+	// Pushing an image that is already present is a no-op.
+	if errs.IsA[*ecrtypes.ImageAlreadyExistsException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func uploadImageBlob(ctx context.Context, conn *ecr.Client, dir, digest, registryID, repositoryName string) error {
+	path, err := imageBlobPath(dir, digest)
+
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	output, err := conn.InitiateLayerUpload(ctx, &ecr.InitiateLayerUploadInput{
+		RegistryId:     aws.String(registryID),
+		RepositoryName: aws.String(repositoryName),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	uploadID := aws.ToString(output.UploadId)
+	buf := make([]byte, aws.ToInt64(output.PartSize))
+	var offset int64
+
+	for {
+		n, err := io.ReadFull(f, buf)
+
+		if n > 0 {
+			_, err := conn.UploadLayerPart(ctx, &ecr.UploadLayerPartInput{
+				LayerPartBlob:  buf[:n],
+				PartFirstByte:  aws.Int64(offset),
+				PartLastByte:   aws.Int64(offset + int64(n) - 1),
+				RegistryId:     aws.String(registryID),
+				RepositoryName: aws.String(repositoryName),
+				UploadId:       aws.String(uploadID),
+			})
+
+			if err != nil {
+				return err
+			}
+
+			offset += int64(n)
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = conn.CompleteLayerUpload(ctx, &ecr.CompleteLayerUploadInput{
+		LayerDigests:   []string{digest},
+		RegistryId:     aws.String(registryID),
+		RepositoryName: aws.String(repositoryName),
+		UploadId:       aws.String(uploadID),
+	})
+
+	// Another push may have uploaded the same layer concurrently.
+	if errs.IsA[*ecrtypes.LayerAlreadyExistsException](err) {
+		return nil
+	}
+
+	return err
+}
+
+// customizeDiffImagePush pins image_uri to the digest of the image in image_push.source
+// so that rebuilding the image plans a code update.
+func customizeDiffImagePush(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	v, ok := d.GetOk("image_push")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil
+	}
+
+	if packageType := d.Get("package_type").(string); packageType != string(awstypes.PackageTypeImage) {
+		return fmt.Errorf("image_push requires package_type to be %q", awstypes.PackageTypeImage)
+	}
+
+	for _, key := range []string{"image_push.0.registry_id", "image_push.0.repository_name", "image_push.0.source"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("image_uri")
+		}
+	}
+
+	tfMap := v.([]interface{})[0].(map[string]interface{})
+	registryID, repositoryName, _ := expandImagePush(ctx, tfMap, meta)
+	source := tfMap[names.AttrSource].(string)
+
+	layout, err := readImageLayout(source)
+
+	if err != nil {
+		return fmt.Errorf("reading OCI image layout (%s): %w", source, err)
+	}
+
+	imageURI := imagePushURI(registryID, meta.(*conns.AWSClient).RegionalHostname(ctx, "dkr.ecr"), repositoryName, layout.manifestDigest)
+
+	if d.Get("image_uri").(string) != imageURI {
+		return d.SetNew("image_uri", imageURI)
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func writeTestImageBlob(t *testing.T, dir string, b []byte) string {
+	t.Helper()
+
+	digest := imageBlobDigest(b)
+	path := filepath.Join(dir, "blobs", "sha256", strings.TrimPrefix(digest, "sha256:"))
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return digest
+}
+
+func writeTestImageIndex(t *testing.T, dir string, manifests ...ociDescriptor) {
+	t.Helper()
+
+	b, err := json.Marshal(ociIndex{Manifests: manifests})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "index.json"), b, 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeTestImageLayout(t *testing.T, dir string) (string, []string) {
+	t.Helper()
+
+	config := writeTestImageBlob(t, dir, []byte(`{"architecture":"amd64","os":"linux"}`))
+	layer1 := writeTestImageBlob(t, dir, []byte("layer1"))
+	layer2 := writeTestImageBlob(t, dir, []byte("layer2"))
+
+	manifest, err := json.Marshal(ociManifest{
+		Config:    ociDescriptor{Digest: config, MediaType: "application/vnd.oci.image.config.v1+json"},
+		Layers:    []ociDescriptor{{Digest: layer1}, {Digest: layer2}, {Digest: layer1}},
+		MediaType: ociImageManifestMediaType,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	digest := writeTestImageBlob(t, dir, manifest)
+	writeTestImageIndex(t, dir, ociDescriptor{Digest: digest, MediaType: ociImageManifestMediaType, Size: int64(len(manifest))})
+
+	return digest, []string{config, layer1, layer2}
+}
+
+func TestReadImageLayout(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	wantDigest, wantBlobDigests := writeTestImageLayout(t, dir)
+
+	layout, err := readImageLayout(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := layout.manifestDigest, wantDigest; got != want {
+		t.Errorf("manifestDigest = %q, want %q", got, want)
+	}
+	if got, want := layout.manifestMediaType, ociImageManifestMediaType; got != want {
+		t.Errorf("manifestMediaType = %q, want %q", got, want)
+	}
+	if got, want := layout.blobDigests, wantBlobDigests; !slices.Equal(got, want) {
+		t.Errorf("blobDigests = %v, want %v", got, want)
+	}
+}
+
+func TestReadImageLayout_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		setup   func(t *testing.T, dir string)
+		wantErr string
+	}{
+		"missing index": {
+			setup:   func(t *testing.T, dir string) {},
+			wantErr: "index.json",
+		},
+		"multiple manifests": {
+			setup: func(t *testing.T, dir string) {
+				digest, _ := writeTestImageLayout(t, dir)
+				writeTestImageIndex(t, dir,
+					ociDescriptor{Digest: digest, MediaType: ociImageManifestMediaType},
+					ociDescriptor{Digest: digest, MediaType: ociImageManifestMediaType},
+				)
+			},
+			wantErr: "exactly one image manifest",
+		},
+		"image index": {
+			setup: func(t *testing.T, dir string) {
+				digest := writeTestImageBlob(t, dir, []byte(`{"manifests":[]}`))
+				writeTestImageIndex(t, dir, ociDescriptor{Digest: digest, MediaType: ociImageIndexMediaType})
+			},
+			wantErr: "multi-platform",
+		},
+		"digest mismatch": {
+			setup: func(t *testing.T, dir string) {
+				digest, _ := writeTestImageLayout(t, dir)
+				path, _ := imageBlobPath(dir, digest)
+				if err := os.WriteFile(path, []byte(`{}`), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantErr: "does not match",
+		},
+		"invalid digest": {
+			setup: func(t *testing.T, dir string) {
+				writeTestImageIndex(t, dir, ociDescriptor{Digest: "sha256:../../etc/passwd", MediaType: ociImageManifestMediaType})
+			},
+			wantErr: "unsupported image blob digest",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			testCase.setup(t, dir)
+
+			_, err := readImageLayout(dir)
+
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("error = %q, want to contain %q", err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+func TestImagePushURI(t *testing.T) {
+	t.Parallel()
+
+	got := imagePushURI("123456789012", "dkr.ecr.us-west-2.amazonaws.com", "example", "sha256:abc")
+	want := "123456789012.dkr.ecr.us-west-2.amazonaws.com/example@sha256:abc"
+
+	if got != want {
+		t.Errorf("imagePushURI = %q, want %q", got, want)
+	}
+}
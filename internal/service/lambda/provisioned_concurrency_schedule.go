@@ -0,0 +1,404 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	appautoscalingtypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	tfappautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_lambda_provisioned_concurrency_schedule", name="Provisioned Concurrency Schedule")
+func resourceProvisionedConcurrencySchedule() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceProvisionedConcurrencyScheduleCreate,
+		ReadWithoutTimeout:   resourceProvisionedConcurrencyScheduleRead,
+		UpdateWithoutTimeout: resourceProvisionedConcurrencyScheduleUpdate,
+		DeleteWithoutTimeout: resourceProvisionedConcurrencyScheduleDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			names.AttrMaxCapacity: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"qualifier": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"scheduled_action": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						names.AttrMaxCapacity: {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+						"min_capacity": {
+							Type:         nullable.TypeNullableInt,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableIntAtLeast(0),
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrSchedule: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrStartTime: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+						"timezone": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "UTC",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+const (
+	provisionedConcurrencyScheduleResourceIDPartCount = 2
+)
+
+func resourceProvisionedConcurrencyScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	id, err := flex.FlattenResourceId([]string{functionName, qualifier}, provisionedConcurrencyScheduleResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+	resourceID := provisionedConcurrencyScalableTargetResourceID(functionName, qualifier)
+
+	if err := registerProvisionedConcurrencyScalableTarget(ctx, conn, resourceID, d.Get("min_capacity").(int), d.Get(names.AttrMaxCapacity).(int)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Lambda Provisioned Concurrency Schedule (%s): registering scalable target: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	for _, tfMapRaw := range d.Get("scheduled_action").(*schema.Set).List() {
+		if err := putProvisionedConcurrencyScheduledAction(ctx, conn, resourceID, tfMapRaw.(map[string]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Lambda Provisioned Concurrency Schedule (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceProvisionedConcurrencyScheduleRead(ctx, d, meta)...)
+}
+
+func resourceProvisionedConcurrencyScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), provisionedConcurrencyScheduleResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	functionName, qualifier := parts[0], parts[1]
+	resourceID := provisionedConcurrencyScalableTargetResourceID(functionName, qualifier)
+
+	target, err := tfappautoscaling.FindTargetByThreePartKey(ctx, conn, resourceID, string(appautoscalingtypes.ServiceNamespaceLambda), string(appautoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Provisioned Concurrency Schedule (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Provisioned Concurrency Schedule (%s) scalable target: %s", d.Id(), err)
+	}
+
+	scheduledActions, err := findProvisionedConcurrencyScheduledActions(ctx, conn, resourceID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Provisioned Concurrency Schedule (%s) scheduled actions: %s", d.Id(), err)
+	}
+
+	d.Set("function_name", functionName)
+	d.Set(names.AttrMaxCapacity, target.MaxCapacity)
+	d.Set("min_capacity", target.MinCapacity)
+	d.Set("qualifier", qualifier)
+	if err := d.Set("scheduled_action", flattenProvisionedConcurrencyScheduledActions(scheduledActions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting scheduled_action: %s", err)
+	}
+
+	return diags
+}
+
+func resourceProvisionedConcurrencyScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+	resourceID := provisionedConcurrencyScalableTargetResourceID(d.Get("function_name").(string), d.Get("qualifier").(string))
+
+	if d.HasChanges(names.AttrMaxCapacity, "min_capacity") {
+		if err := registerProvisionedConcurrencyScalableTarget(ctx, conn, resourceID, d.Get("min_capacity").(int), d.Get(names.AttrMaxCapacity).(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Lambda Provisioned Concurrency Schedule (%s): registering scalable target: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("scheduled_action") {
+		o, n := d.GetChange("scheduled_action")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		newNames := make(map[string]bool)
+		for _, tfMapRaw := range ns.List() {
+			newNames[tfMapRaw.(map[string]interface{})[names.AttrName].(string)] = true
+		}
+
+		for _, tfMapRaw := range os.Difference(ns).List() {
+			name := tfMapRaw.(map[string]interface{})[names.AttrName].(string)
+
+			// Actions that are kept by name are overwritten below.
+			if newNames[name] {
+				continue
+			}
+
+			if err := deleteProvisionedConcurrencyScheduledAction(ctx, conn, resourceID, name); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Provisioned Concurrency Schedule (%s): %s", d.Id(), err)
+			}
+		}
+
+		for _, tfMapRaw := range ns.Difference(os).List() {
+			if err := putProvisionedConcurrencyScheduledAction(ctx, conn, resourceID, tfMapRaw.(map[string]interface{})); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Provisioned Concurrency Schedule (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceProvisionedConcurrencyScheduleRead(ctx, d, meta)...)
+}
+
+func resourceProvisionedConcurrencyScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+	resourceID := provisionedConcurrencyScalableTargetResourceID(d.Get("function_name").(string), d.Get("qualifier").(string))
+
+	// Deregistering the scalable target also deletes its scheduled actions.
+	log.Printf("[INFO] Deleting Lambda Provisioned Concurrency Schedule: %s", d.Id())
+	_, err := conn.DeregisterScalableTarget(ctx, &applicationautoscaling.DeregisterScalableTargetInput{
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: appautoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+		ServiceNamespace:  appautoscalingtypes.ServiceNamespaceLambda,
+	})
+
+	if errs.IsA[*appautoscalingtypes.ObjectNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Lambda Provisioned Concurrency Schedule (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, lambdaPropagationTimeout, func() (interface{}, error) {
+		return tfappautoscaling.FindTargetByThreePartKey(ctx, conn, resourceID, string(appautoscalingtypes.ServiceNamespaceLambda), string(appautoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency))
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Lambda Provisioned Concurrency Schedule (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// provisionedConcurrencyScalableTargetResourceID returns the Application Auto Scaling resource ID of a Lambda alias or version.
+// See https://docs.aws.amazon.com/autoscaling/application/APIReference/API_RegisterScalableTarget.html#autoscaling-RegisterScalableTarget-request-ResourceId.
+func provisionedConcurrencyScalableTargetResourceID(functionName, qualifier string) string {
+	return fmt.Sprintf("function:%s:%s", functionName, qualifier)
+}
+
+func registerProvisionedConcurrencyScalableTarget(ctx context.Context, conn *applicationautoscaling.Client, resourceID string, minCapacity, maxCapacity int) error {
+	input := &applicationautoscaling.RegisterScalableTargetInput{
+		MaxCapacity:       aws.Int32(int32(maxCapacity)),
+		MinCapacity:       aws.Int32(int32(minCapacity)),
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: appautoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+		ServiceNamespace:  appautoscalingtypes.ServiceNamespaceLambda,
+	}
+
+	_, err := tfresource.RetryWhenIsAErrorMessageContains[*appautoscalingtypes.ValidationException](ctx, iamPropagationTimeout, func() (interface{}, error) {
+		return conn.RegisterScalableTarget(ctx, input)
+	}, "Unable to assume IAM role")
+
+	return err
+}
+
+func putProvisionedConcurrencyScheduledAction(ctx context.Context, conn *applicationautoscaling.Client, resourceID string, tfMap map[string]interface{}) error {
+	name := tfMap[names.AttrName].(string)
+	input := &applicationautoscaling.PutScheduledActionInput{
+		ResourceId:           aws.String(resourceID),
+		ScalableDimension:    appautoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+		ScalableTargetAction: &appautoscalingtypes.ScalableTargetAction{},
+		Schedule:             aws.String(tfMap[names.AttrSchedule].(string)),
+		ScheduledActionName:  aws.String(name),
+		ServiceNamespace:     appautoscalingtypes.ServiceNamespaceLambda,
+		Timezone:             aws.String(tfMap["timezone"].(string)),
+	}
+
+	if v, ok := tfMap["end_time"].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		input.EndTime = aws.Time(t)
+	}
+
+	if v, null, _ := nullable.Int(tfMap[names.AttrMaxCapacity].(string)).ValueInt32(); !null {
+		input.ScalableTargetAction.MaxCapacity = aws.Int32(v)
+	}
+
+	if v, null, _ := nullable.Int(tfMap["min_capacity"].(string)).ValueInt32(); !null {
+		input.ScalableTargetAction.MinCapacity = aws.Int32(v)
+	}
+
+	if input.ScalableTargetAction.MaxCapacity == nil && input.ScalableTargetAction.MinCapacity == nil {
+		return fmt.Errorf("scheduled action (%s): at least one of max_capacity or min_capacity must be set", name)
+	}
+
+	if v, ok := tfMap[names.AttrStartTime].(string); ok && v != "" {
+		t, _ := time.Parse(time.RFC3339, v)
+		input.StartTime = aws.Time(t)
+	}
+
+	// Retry while the newly registered scalable target propagates.
+	_, err := tfresource.RetryWhenIsA[*appautoscalingtypes.ObjectNotFoundException](ctx, lambdaPropagationTimeout, func() (interface{}, error) {
+		return conn.PutScheduledAction(ctx, input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("putting scheduled action (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func deleteProvisionedConcurrencyScheduledAction(ctx context.Context, conn *applicationautoscaling.Client, resourceID, name string) error {
+	_, err := conn.DeleteScheduledAction(ctx, &applicationautoscaling.DeleteScheduledActionInput{
+		ResourceId:          aws.String(resourceID),
+		ScalableDimension:   appautoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+		ScheduledActionName: aws.String(name),
+		ServiceNamespace:    appautoscalingtypes.ServiceNamespaceLambda,
+	})
+
+	if errs.IsA[*appautoscalingtypes.ObjectNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting scheduled action (%s): %w", name, err)
+	}
+
+	return nil
+}
+
+func findProvisionedConcurrencyScheduledActions(ctx context.Context, conn *applicationautoscaling.Client, resourceID string) ([]appautoscalingtypes.ScheduledAction, error) {
+	input := &applicationautoscaling.DescribeScheduledActionsInput{
+		ResourceId:        aws.String(resourceID),
+		ScalableDimension: appautoscalingtypes.ScalableDimensionLambdaFunctionProvisionedConcurrency,
+		ServiceNamespace:  appautoscalingtypes.ServiceNamespaceLambda,
+	}
+	var output []appautoscalingtypes.ScheduledAction
+
+	pages := applicationautoscaling.NewDescribeScheduledActionsPaginator(conn, input)
+
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ScheduledActions {
+			if aws.ToString(v.ResourceId) == resourceID {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func flattenProvisionedConcurrencyScheduledActions(apiObjects []appautoscalingtypes.ScheduledAction) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrName:     aws.ToString(apiObject.ScheduledActionName),
+			names.AttrSchedule: aws.ToString(apiObject.Schedule),
+			"timezone":         aws.ToString(apiObject.Timezone),
+		}
+
+		if v := apiObject.EndTime; v != nil {
+			tfMap["end_time"] = v.UTC().Format(time.RFC3339)
+		}
+
+		if v := apiObject.StartTime; v != nil {
+			tfMap[names.AttrStartTime] = v.UTC().Format(time.RFC3339)
+		}
+
+		if v := apiObject.ScalableTargetAction; v != nil {
+			if v.MaxCapacity != nil {
+				tfMap[names.AttrMaxCapacity] = strconv.FormatInt(int64(aws.ToInt32(v.MaxCapacity)), 10)
+			}
+			if v.MinCapacity != nil {
+				tfMap["min_capacity"] = strconv.FormatInt(int64(aws.ToInt32(v.MinCapacity)), 10)
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
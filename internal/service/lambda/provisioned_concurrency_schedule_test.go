@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfappautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/appautoscaling"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaProvisionedConcurrencySchedule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_provisioned_concurrency_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedConcurrencyScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedConcurrencyScheduleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisionedConcurrencyScheduleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "function_name", rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrMaxCapacity, "10"),
+					resource.TestCheckResourceAttr(resourceName, "min_capacity", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", "aws_lambda_alias.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_action.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						names.AttrName:     "business-hours",
+						names.AttrSchedule: "cron(0 8 ? * MON-FRI *)",
+						"timezone":         "Europe/London",
+						"min_capacity":     "5",
+						"max_capacity":     "",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						names.AttrName:     "after-hours",
+						names.AttrSchedule: "cron(0 18 ? * MON-FRI *)",
+						"timezone":         "Europe/London",
+						"min_capacity":     "0",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProvisionedConcurrencyScheduleConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisionedConcurrencyScheduleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrMaxCapacity, "20"),
+					resource.TestCheckResourceAttr(resourceName, "min_capacity", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_action.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						names.AttrName:     "business-hours",
+						names.AttrSchedule: "cron(0 7 ? * MON-FRI *)",
+						"timezone":         "UTC",
+						"min_capacity":     "10",
+						"max_capacity":     "20",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaProvisionedConcurrencySchedule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_provisioned_concurrency_schedule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisionedConcurrencyScheduleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisionedConcurrencyScheduleConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProvisionedConcurrencyScheduleExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflambda.ResourceProvisionedConcurrencySchedule(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckProvisionedConcurrencyScheduleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_provisioned_concurrency_schedule" {
+				continue
+			}
+
+			resourceID := tflambda.ProvisionedConcurrencyScalableTargetResourceID(rs.Primary.Attributes["function_name"], rs.Primary.Attributes["qualifier"])
+
+			_, err := tfappautoscaling.FindTargetByThreePartKey(ctx, conn, resourceID, string(awstypes.ServiceNamespaceLambda), string(awstypes.ScalableDimensionLambdaFunctionProvisionedConcurrency))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lambda Provisioned Concurrency Schedule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckProvisionedConcurrencyScheduleExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingClient(ctx)
+
+		resourceID := tflambda.ProvisionedConcurrencyScalableTargetResourceID(rs.Primary.Attributes["function_name"], rs.Primary.Attributes["qualifier"])
+
+		_, err := tfappautoscaling.FindTargetByThreePartKey(ctx, conn, resourceID, string(awstypes.ServiceNamespaceLambda), string(awstypes.ScalableDimensionLambdaFunctionProvisionedConcurrency))
+
+		if err != nil {
+			return err
+		}
+
+		output, err := tflambda.FindProvisionedConcurrencyScheduledActions(ctx, conn, resourceID)
+
+		if err != nil {
+			return err
+		}
+
+		if len(output) == 0 {
+			return fmt.Errorf("Lambda Provisioned Concurrency Schedule %s has no scheduled actions", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccProvisionedConcurrencyScheduleConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccProvisionedConcurrencyConfigConfig_base(rName),
+		`
+resource "aws_lambda_alias" "test" {
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+  name             = "live"
+}
+`)
+}
+
+func testAccProvisionedConcurrencyScheduleConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccProvisionedConcurrencyScheduleConfig_base(rName),
+		`
+resource "aws_lambda_provisioned_concurrency_schedule" "test" {
+  function_name = aws_lambda_function.test.function_name
+  qualifier     = aws_lambda_alias.test.name
+  min_capacity  = 0
+  max_capacity  = 10
+
+  scheduled_action {
+    name         = "business-hours"
+    schedule     = "cron(0 8 ? * MON-FRI *)"
+    timezone     = "Europe/London"
+    min_capacity = 5
+  }
+
+  scheduled_action {
+    name         = "after-hours"
+    schedule     = "cron(0 18 ? * MON-FRI *)"
+    timezone     = "Europe/London"
+    min_capacity = 0
+  }
+}
+`)
+}
+
+func testAccProvisionedConcurrencyScheduleConfig_updated(rName string) string {
+	return acctest.ConfigCompose(
+		testAccProvisionedConcurrencyScheduleConfig_base(rName),
+		`
+resource "aws_lambda_provisioned_concurrency_schedule" "test" {
+  function_name = aws_lambda_function.test.function_name
+  qualifier     = aws_lambda_alias.test.name
+  min_capacity  = 1
+  max_capacity  = 20
+
+  scheduled_action {
+    name         = "business-hours"
+    schedule     = "cron(0 7 ? * MON-FRI *)"
+    min_capacity = 10
+    max_capacity = 20
+  }
+}
+`)
+}
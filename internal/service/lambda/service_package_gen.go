@@ -146,6 +146,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_lambda_provisioned_concurrency_config",
 			Name:     "Provisioned Concurrency Config",
 		},
+		{
+			Factory:  resourceProvisionedConcurrencySchedule,
+			TypeName: "aws_lambda_provisioned_concurrency_schedule",
+			Name:     "Provisioned Concurrency Schedule",
+		},
 	}
 }
 
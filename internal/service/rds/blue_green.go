@@ -162,3 +162,94 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	return nil
 }
+
+type clusterHandler struct {
+	conn *rds.Client
+}
+
+func newClusterHandler(conn *rds.Client) *clusterHandler {
+	return &clusterHandler{
+		conn: conn,
+	}
+}
+
+func (h *clusterHandler) createBlueGreenInput(d *schema.ResourceData) *rds.CreateBlueGreenDeploymentInput {
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get(names.AttrARN).(string)),
+	}
+
+	if d.HasChange(names.AttrEngineVersion) {
+		input.TargetEngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+	if v, ok := d.GetOk("db_instance_parameter_group_name"); ok && d.HasChange("db_instance_parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(v.(string))
+	}
+
+	return input
+}
+
+// deleteSource deletes the Blue environment's DB instances and then starts deleting its DB cluster.
+func (h *clusterHandler) deleteSource(ctx context.Context, identifier string, timeout time.Duration) error {
+	cluster, err := findDBClusterByID(ctx, h.conn, identifier)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range cluster.DBClusterMembers {
+		instanceID := aws.ToString(v.DBInstanceIdentifier)
+		_, err := h.conn.DeleteDBInstance(ctx, &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(instanceID),
+			SkipFinalSnapshot:    aws.Bool(true),
+		})
+
+		if errs.IsA[*types.DBInstanceNotFoundFault](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting DB instance (%s): %s", instanceID, err)
+		}
+	}
+
+	for _, v := range cluster.DBClusterMembers {
+		instanceID := aws.ToString(v.DBInstanceIdentifier)
+		if _, err := waitDBClusterInstanceDeleted(ctx, h.conn, instanceID, timeout); err != nil {
+			return fmt.Errorf("deleting DB instance (%s): waiting for completion: %s", instanceID, err)
+		}
+	}
+
+	if aws.ToBool(cluster.DeletionProtection) {
+		_, err := tfresource.RetryWhenIsA[*types.InvalidDBClusterStateFault](ctx, timeout, func() (interface{}, error) {
+			return h.conn.ModifyDBCluster(ctx, &rds.ModifyDBClusterInput{
+				ApplyImmediately:    aws.Bool(true),
+				DBClusterIdentifier: aws.String(identifier),
+				DeletionProtection:  aws.Bool(false),
+			})
+		})
+
+		if err != nil {
+			return fmt.Errorf("disabling deletion protection: %s", err)
+		}
+
+		if _, err := waitDBClusterAvailable(ctx, h.conn, identifier, false, timeout); err != nil {
+			return fmt.Errorf("disabling deletion protection: waiting for completion: %s", err)
+		}
+	}
+
+	_, err = tfresource.RetryWhenIsA[*types.InvalidDBClusterStateFault](ctx, timeout, func() (interface{}, error) {
+		return h.conn.DeleteDBCluster(ctx, &rds.DeleteDBClusterInput{
+			DBClusterIdentifier: aws.String(identifier),
+			SkipFinalSnapshot:   aws.Bool(true),
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
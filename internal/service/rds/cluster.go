@@ -5,8 +5,10 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -107,6 +109,19 @@ func resourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			names.AttrClusterIdentifier: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -631,6 +646,25 @@ func resourceCluster() *schema.Resource {
 				}
 				return nil
 			},
+			func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				engine := d.Get(names.AttrEngine).(string)
+				if !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+
+				if v := d.Get("global_cluster_identifier").(string); v != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+
+				if v := d.Get("replication_source_identifier").(string); v != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "replication_source_identifier" is set.`)
+				}
+				return nil
+			},
 		),
 	}
 }
@@ -1430,15 +1464,41 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
-	if d.HasChangesExcept(
+	// Engine version and parameter group changes are applied through a Blue/Green Deployment
+	// when enabled. Any other changes are then applied to the new (Green) cluster as usual.
+	blueGreen := d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(
+		"db_cluster_parameter_group_name",
+		"db_instance_parameter_group_name",
+		names.AttrEngineVersion,
+	)
+
+	if blueGreen {
+		diags = append(diags, clusterBlueGreenUpdate(ctx, conn, d, d.Timeout(schema.TimeoutUpdate))...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	modifyExcept := []string{
 		names.AttrAllowMajorVersionUpgrade,
+		"blue_green_update",
 		"delete_automated_backups",
 		names.AttrFinalSnapshotIdentifier,
 		"global_cluster_identifier",
 		"iam_roles",
 		"replication_source_identifier",
 		"skip_final_snapshot",
-		names.AttrTags, names.AttrTagsAll) {
+		names.AttrTags, names.AttrTagsAll,
+	}
+	if blueGreen {
+		modifyExcept = append(modifyExcept,
+			"db_cluster_parameter_group_name",
+			"db_instance_parameter_group_name",
+			names.AttrEngineVersion,
+		)
+	}
+
+	if d.HasChangesExcept(modifyExcept...) {
 		applyImmediately := d.Get(names.AttrApplyImmediately).(bool)
 		input := &rds.ModifyDBClusterInput{
 			ApplyImmediately:    aws.Bool(applyImmediately),
@@ -1473,7 +1533,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
 		}
 
-		if d.HasChange("db_cluster_parameter_group_name") {
+		if d.HasChange("db_cluster_parameter_group_name") && !blueGreen {
 			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
 		}
 
@@ -1482,7 +1542,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		// set, the configured attribute should always be sent on modify.
 		// Except, this causes an error on a minor version upgrade, so it is
 		// removed during update retry, if necessary.
-		if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
+		if v, ok := d.GetOk("db_instance_parameter_group_name"); (ok || d.HasChange("db_instance_parameter_group_name")) && !blueGreen {
 			input.DBInstanceParameterGroupName = aws.String(v.(string))
 		}
 
@@ -1518,14 +1578,14 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			}
 		}
 
-		if d.HasChange(names.AttrEngineVersion) {
+		if d.HasChange(names.AttrEngineVersion) && !blueGreen {
 			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
 		}
 
 		// This can happen when updates are deferred (apply_immediately = false), and
 		// multiple applies occur before the maintenance window. In this case,
 		// continue sending the desired engine_version as part of the modify request.
-		if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) {
+		if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) && !blueGreen {
 			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
 		}
 
@@ -1689,6 +1749,95 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
+func clusterBlueGreenUpdate(ctx context.Context, conn *rds.Client, d *schema.ResourceData, timeout time.Duration) (diags diag.Diagnostics) {
+	deadline := tfresource.NewDeadline(timeout)
+
+	orchestrator := newBlueGreenOrchestrator(conn)
+	defer orchestrator.CleanUp(ctx)
+
+	handler := newClusterHandler(conn)
+
+	createIn := handler.createBlueGreenInput(d)
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Creating Blue/Green Deployment", d.Id())
+
+	dep, err := orchestrator.CreateDeployment(ctx, createIn)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	deploymentIdentifier := dep.BlueGreenDeploymentIdentifier
+	defer func() {
+		log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
+
+		if dep == nil {
+			log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment: deployment disappeared", d.Id())
+			return
+		}
+
+		// Ensure that the Blue/Green Deployment is always cleaned up
+		input := &rds.DeleteBlueGreenDeploymentInput{
+			BlueGreenDeploymentIdentifier: deploymentIdentifier,
+		}
+		if aws.ToString(dep.Status) != "SWITCHOVER_COMPLETED" {
+			input.DeleteTarget = aws.Bool(true)
+		}
+
+		_, err = conn.DeleteBlueGreenDeployment(ctx, input)
+
+		if err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: %s", d.Id(), err)
+			return
+		}
+
+		orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+			if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, aws.ToString(deploymentIdentifier), deadline.Remaining(), optFns...); err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: waiting for completion: %s", d.Id(), err)
+			}
+		})
+	}()
+
+	dep, err = orchestrator.waitForDeploymentAvailable(ctx, aws.ToString(dep.BlueGreenDeploymentIdentifier), deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	targetARN, err := parseDBClusterARN(aws.ToString(dep.Target))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
+	}
+
+	if _, err := waitDBClusterAvailable(ctx, conn, targetARN.Identifier, false, deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Switching over Blue/Green Deployment", d.Id())
+
+	dep, err = orchestrator.Switchover(ctx, aws.ToString(dep.BlueGreenDeploymentIdentifier), deadline.Remaining())
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment source", d.Id())
+
+	sourceARN, err := parseDBClusterARN(aws.ToString(dep.Source))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+	}
+
+	if err := handler.deleteSource(ctx, sourceARN.Identifier, deadline.Remaining()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+	}
+
+	orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+		if _, err := waitDBClusterDeleted(ctx, conn, sourceARN.Identifier, deadline.Remaining()); err != nil {
+			diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: waiting for completion: %s", d.Id(), err)
+		}
+	})
+
+	return diags
+}
+
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
@@ -1904,6 +2053,31 @@ func findDBClusterByID(ctx context.Context, conn *rds.Client, id string, optFns
 	return output, nil
 }
 
+type dbClusterARN struct {
+	arn.ARN
+	Identifier string
+}
+
+func parseDBClusterARN(s string) (dbClusterARN, error) {
+	arn, err := arn.Parse(s)
+	if err != nil {
+		return dbClusterARN{}, err
+	}
+
+	result := dbClusterARN{
+		ARN: arn,
+	}
+
+	re := regexache.MustCompile(`^cluster:([0-9a-z-]+)$`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if matches == nil || len(matches) != 2 {
+		return dbClusterARN{}, errors.New("DB Cluster ARN: invalid resource section")
+	}
+	result.Identifier = matches[1]
+
+	return result, nil
+}
+
 func findDBCluster(ctx context.Context, conn *rds.Client, input *rds.DescribeDBClustersInput, filter tfslices.Predicate[*types.DBCluster], optFns ...func(*rds.Options)) (*types.DBCluster, error) {
 	output, err := findDBClusters(ctx, conn, input, filter, optFns...)
 
@@ -2200,3 +2374,10 @@ func flattenServerlessV2ScalingConfigurationInfo(apiObject *types.ServerlessV2Sc
 
 	return tfMap
 }
+
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
@@ -115,11 +115,13 @@ const (
 )
 
 const (
-	globalClusterStatusAvailable = "available"
-	globalClusterStatusCreating  = "creating"
-	globalClusterStatusDeleting  = "deleting"
-	globalClusterStatusModifying = "modifying"
-	globalClusterStatusUpgrading = "upgrading"
+	globalClusterStatusAvailable     = "available"
+	globalClusterStatusCreating      = "creating"
+	globalClusterStatusDeleting      = "deleting"
+	globalClusterStatusFailingOver   = "failing-over"
+	globalClusterStatusModifying     = "modifying"
+	globalClusterStatusSwitchingOver = "switching-over"
+	globalClusterStatusUpgrading     = "upgrading"
 )
 
 const (
@@ -16,6 +16,7 @@ var (
 	ResourceCustomDBEngineVersion               = resourceCustomDBEngineVersion
 	ResourceEventSubscription                   = resourceEventSubscription
 	ResourceGlobalCluster                       = resourceGlobalCluster
+	ResourceGlobalClusterSwitchover             = newGlobalClusterSwitchoverResource
 	ResourceInstance                            = resourceInstance
 	ResourceInstanceState                       = newResourceInstanceState
 	ResourceInstanceAutomatedBackupsReplication = resourceInstanceAutomatedBackupsReplication
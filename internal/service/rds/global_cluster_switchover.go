@@ -0,0 +1,368 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_rds_global_cluster_switchover", name="Global Cluster Switchover")
+func newGlobalClusterSwitchoverResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &globalClusterSwitchoverResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultUpdateTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type globalClusterSwitchoverResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*globalClusterSwitchoverResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_rds_global_cluster_switchover"
+}
+
+func (r *globalClusterSwitchoverResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"allow_data_loss": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"global_cluster_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"target_db_cluster_identifier": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *globalClusterSwitchoverResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data globalClusterSwitchoverResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	globalClusterID, targetARN := data.GlobalClusterIdentifier.ValueString(), data.TargetDBClusterIdentifier.ValueString()
+	if err := switchoverGlobalCluster(ctx, conn, globalClusterID, targetARN, data.AllowDataLoss.ValueBool(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("switching over RDS Global Cluster (%s) to RDS Cluster (%s)", globalClusterID, targetARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *globalClusterSwitchoverResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data globalClusterSwitchoverResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	output, err := findGlobalClusterByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading RDS Global Cluster (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Report any switchover or failover made outside Terraform as drift.
+	if v := globalClusterWriterARN(output); v != "" {
+		data.TargetDBClusterIdentifier = fwtypes.ARNValue(v)
+	}
+
+	// Set attributes for import.
+	if data.AllowDataLoss.IsNull() {
+		data.AllowDataLoss = types.BoolValue(false)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *globalClusterSwitchoverResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old globalClusterSwitchoverResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().RDSClient(ctx)
+
+	if !new.TargetDBClusterIdentifier.Equal(old.TargetDBClusterIdentifier) {
+		globalClusterID, targetARN := new.GlobalClusterIdentifier.ValueString(), new.TargetDBClusterIdentifier.ValueString()
+		if err := switchoverGlobalCluster(ctx, conn, globalClusterID, targetARN, new.AllowDataLoss.ValueBool(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("switching over RDS Global Cluster (%s) to RDS Cluster (%s)", globalClusterID, targetARN), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *globalClusterSwitchoverResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), request, response)
+}
+
+// switchoverGlobalCluster makes the specified member cluster the primary cluster of the RDS Global Cluster.
+// A planned switchover waits for the target cluster to be fully synchronized so that no data is lost.
+// When data loss is allowed, an unplanned failover is started without waiting for replication to catch up.
+func switchoverGlobalCluster(ctx context.Context, conn *rds.Client, globalClusterID, targetARN string, allowDataLoss bool, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	globalCluster, err := findGlobalClusterByID(ctx, conn, globalClusterID)
+
+	if err != nil {
+		return fmt.Errorf("reading RDS Global Cluster (%s): %w", globalClusterID, err)
+	}
+
+	if globalClusterWriterARN(globalCluster) == targetARN {
+		log.Printf("[DEBUG] RDS Cluster (%s) is already the primary cluster of RDS Global Cluster (%s)", targetARN, globalClusterID)
+
+		return nil
+	}
+
+	if findGlobalClusterMemberByARN(globalCluster, targetARN) == nil {
+		return fmt.Errorf("RDS Cluster (%s) is not a member of RDS Global Cluster (%s)", targetARN, globalClusterID)
+	}
+
+	if allowDataLoss {
+		input := &rds.FailoverGlobalClusterInput{
+			AllowDataLoss:             aws.Bool(true),
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(targetARN),
+		}
+
+		if _, err := conn.FailoverGlobalCluster(ctx, input); err != nil {
+			return fmt.Errorf("failing over: %w", err)
+		}
+	} else {
+		if _, err := waitGlobalClusterMemberSynchronized(ctx, conn, globalClusterID, targetARN, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for replication to RDS Cluster (%s): %w", targetARN, err)
+		}
+
+		input := &rds.SwitchoverGlobalClusterInput{
+			GlobalClusterIdentifier:   aws.String(globalClusterID),
+			TargetDbClusterIdentifier: aws.String(targetARN),
+		}
+
+		if _, err := conn.SwitchoverGlobalCluster(ctx, input); err != nil {
+			return fmt.Errorf("switching over: %w", err)
+		}
+	}
+
+	if _, err := waitGlobalClusterSwitchedOver(ctx, conn, globalClusterID, targetARN, deadline.Remaining()); err != nil {
+		return fmt.Errorf("waiting for RDS Cluster (%s) promotion: %w", targetARN, err)
+	}
+
+	return nil
+}
+
+func globalClusterWriterARN(globalCluster *awstypes.GlobalCluster) string {
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.ToBool(v.IsWriter) {
+			return aws.ToString(v.DBClusterArn)
+		}
+	}
+
+	return ""
+}
+
+func findGlobalClusterMemberByARN(globalCluster *awstypes.GlobalCluster, dbClusterARN string) *awstypes.GlobalClusterMember {
+	for _, v := range globalCluster.GlobalClusterMembers {
+		if aws.ToString(v.DBClusterArn) == dbClusterARN {
+			return &v
+		}
+	}
+
+	return nil
+}
+
+func statusGlobalClusterMemberSynchronization(ctx context.Context, conn *rds.Client, globalClusterID, dbClusterARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findGlobalClusterByID(ctx, conn, globalClusterID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		member := findGlobalClusterMemberByARN(output, dbClusterARN)
+
+		if member == nil {
+			return nil, "", nil
+		}
+
+		// Replication to the member can only be relied upon once the global cluster itself is stable.
+		if status := aws.ToString(output.Status); status != globalClusterStatusAvailable {
+			return output, status, nil
+		}
+
+		return output, string(member.SynchronizationStatus), nil
+	}
+}
+
+func statusGlobalClusterSwitchover(ctx context.Context, conn *rds.Client, globalClusterID, targetARN string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findGlobalClusterByID(ctx, conn, globalClusterID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if v := output.FailoverState; v != nil && v.Status != "" {
+			return output, string(v.Status), nil
+		}
+
+		if status := aws.ToString(output.Status); status != globalClusterStatusAvailable {
+			return output, status, nil
+		}
+
+		// The request has been accepted but the target has not yet been promoted.
+		if globalClusterWriterARN(output) != targetARN {
+			return output, string(awstypes.FailoverStatusPending), nil
+		}
+
+		return output, globalClusterStatusAvailable, nil
+	}
+}
+
+func waitGlobalClusterMemberSynchronized(ctx context.Context, conn *rds.Client, globalClusterID, dbClusterARN string, timeout time.Duration) (*awstypes.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			globalClusterStatusModifying,
+			globalClusterStatusUpgrading,
+			string(awstypes.GlobalClusterMemberSynchronizationStatusPendingResync),
+		},
+		Target:                    []string{string(awstypes.GlobalClusterMemberSynchronizationStatusConnected)},
+		Refresh:                   statusGlobalClusterMemberSynchronization(ctx, conn, globalClusterID, dbClusterARN),
+		Timeout:                   timeout,
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGlobalClusterSwitchedOver(ctx context.Context, conn *rds.Client, globalClusterID, targetARN string, timeout time.Duration) (*awstypes.GlobalCluster, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			globalClusterStatusFailingOver,
+			globalClusterStatusModifying,
+			globalClusterStatusSwitchingOver,
+			string(awstypes.FailoverStatusFailingOver),
+			string(awstypes.FailoverStatusPending),
+		},
+		Target:     []string{globalClusterStatusAvailable},
+		Refresh:    statusGlobalClusterSwitchover(ctx, conn, globalClusterID, targetARN),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.GlobalCluster); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type globalClusterSwitchoverResourceModel struct {
+	AllowDataLoss             types.Bool     `tfsdk:"allow_data_loss"`
+	GlobalClusterIdentifier   types.String   `tfsdk:"global_cluster_identifier"`
+	ID                        types.String   `tfsdk:"id"`
+	TargetDBClusterIdentifier fwtypes.ARN    `tfsdk:"target_db_cluster_identifier"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (model *globalClusterSwitchoverResourceModel) InitFromID() error {
+	model.GlobalClusterIdentifier = model.ID
+
+	return nil
+}
+
+func (model *globalClusterSwitchoverResourceModel) setID() {
+	model.ID = model.GlobalClusterIdentifier
+}
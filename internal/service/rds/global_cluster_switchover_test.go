@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRDSGlobalClusterSwitchover_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rNameGlobal := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNamePrimary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameSecondary := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_global_cluster_switchover.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckGlobalCluster(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckGlobalClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalClusterSwitchoverConfig_basic(rNameGlobal, rNamePrimary, rNameSecondary, "primary"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalClusterSwitchoverExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_data_loss", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, "global_cluster_identifier", "aws_rds_global_cluster.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "target_db_cluster_identifier", "aws_rds_cluster.primary", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlobalClusterSwitchoverConfig_basic(rNameGlobal, rNamePrimary, rNameSecondary, "secondary"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalClusterSwitchoverExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "target_db_cluster_identifier", "aws_rds_cluster.secondary", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccGlobalClusterSwitchoverConfig_basic(rNameGlobal, rNamePrimary, rNameSecondary, "primary"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGlobalClusterSwitchoverExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "target_db_cluster_identifier", "aws_rds_cluster.primary", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGlobalClusterSwitchoverExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).RDSClient(ctx)
+
+		output, err := tfrds.FindGlobalClusterByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		want := rs.Primary.Attributes["target_db_cluster_identifier"]
+		for _, v := range output.GlobalClusterMembers {
+			if aws.ToBool(v.IsWriter) {
+				if got := aws.ToString(v.DBClusterArn); got != want {
+					return fmt.Errorf("RDS Global Cluster %s primary cluster: got %s, want %s", rs.Primary.ID, got, want)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("RDS Global Cluster %s has no primary cluster", rs.Primary.ID)
+	}
+}
+
+func testAccGlobalClusterSwitchoverConfig_basic(rNameGlobal, rNamePrimary, rNameSecondary, target string) string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(2), fmt.Sprintf(`
+data "aws_availability_zones" "alternate" {
+  provider = "awsalternate"
+  state    = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+data "aws_rds_engine_version" "test" {
+  engine = %[1]q
+  latest = true
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.test.engine
+  engine_version             = data.aws_rds_engine_version.test.version_actual
+  preferred_instance_classes = [%[2]s]
+  supports_clusters          = true
+  supports_global_databases  = true
+}
+
+resource "aws_rds_global_cluster" "test" {
+  global_cluster_identifier = %[3]q
+  engine                    = data.aws_rds_engine_version.test.engine
+  engine_version            = data.aws_rds_engine_version.test.version_actual
+}
+
+resource "aws_rds_cluster" "primary" {
+  cluster_identifier        = %[4]q
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+  global_cluster_identifier = aws_rds_global_cluster.test.id
+  master_password           = "avoid-plaintext-passwords"
+  master_username           = "tfacctest"
+  skip_final_snapshot       = true
+
+  lifecycle {
+    ignore_changes = [replication_source_identifier]
+  }
+}
+
+resource "aws_rds_cluster_instance" "primary" {
+  cluster_identifier = aws_rds_cluster.primary.id
+  engine             = aws_rds_cluster.primary.engine
+  engine_version     = aws_rds_cluster.primary.engine_version
+  identifier         = %[4]q
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+resource "aws_vpc" "alternate" {
+  provider   = "awsalternate"
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[5]q
+  }
+}
+
+resource "aws_subnet" "alternate" {
+  provider          = "awsalternate"
+  count             = 3
+  vpc_id            = aws_vpc.alternate.id
+  availability_zone = data.aws_availability_zones.alternate.names[count.index]
+  cidr_block        = "10.0.${count.index}.0/24"
+
+  tags = {
+    Name = %[5]q
+  }
+}
+
+resource "aws_db_subnet_group" "alternate" {
+  provider   = "awsalternate"
+  name       = %[5]q
+  subnet_ids = aws_subnet.alternate[*].id
+}
+
+resource "aws_rds_cluster" "secondary" {
+  provider                  = "awsalternate"
+  cluster_identifier        = %[5]q
+  db_subnet_group_name      = aws_db_subnet_group.alternate.name
+  engine                    = aws_rds_global_cluster.test.engine
+  engine_version            = aws_rds_global_cluster.test.engine_version
+  global_cluster_identifier = aws_rds_global_cluster.test.id
+  skip_final_snapshot       = true
+
+  lifecycle {
+    ignore_changes = [replication_source_identifier]
+  }
+
+  depends_on = [aws_rds_cluster_instance.primary]
+}
+
+resource "aws_rds_cluster_instance" "secondary" {
+  provider           = "awsalternate"
+  cluster_identifier = aws_rds_cluster.secondary.id
+  engine             = aws_rds_cluster.secondary.engine
+  engine_version     = aws_rds_cluster.secondary.engine_version
+  identifier         = %[5]q
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+
+resource "aws_rds_global_cluster_switchover" "test" {
+  global_cluster_identifier    = aws_rds_global_cluster.test.id
+  target_db_cluster_identifier = aws_rds_cluster.%[6]s.arn
+
+  depends_on = [aws_rds_cluster_instance.primary, aws_rds_cluster_instance.secondary]
+}
+`, tfrds.ClusterEngineAuroraPostgreSQL, mainInstanceClasses, rNameGlobal, rNamePrimary, rNameSecondary, target))
+}
@@ -684,6 +684,11 @@ func resourceInstance() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"wait_for_storage_optimization": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -2104,6 +2109,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		"replicate_source_db",
 		"skip_final_snapshot",
 		names.AttrTags, names.AttrTagsAll,
+		"wait_for_storage_optimization",
 	) {
 		if d.Get("blue_green_update.0.enabled").(bool) && d.HasChangesExcept(
 			names.AttrAllowMajorVersionUpgrade,
@@ -2113,6 +2119,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			"replicate_source_db",
 			"skip_final_snapshot",
 			names.AttrTags, names.AttrTagsAll,
+			"wait_for_storage_optimization",
 			names.AttrDeletionProtection,
 			names.AttrPassword,
 		) {
@@ -2299,6 +2306,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	// Storage changes leave the DB instance in storage-optimization, during which further storage changes are rejected.
+	if d.Get("wait_for_storage_optimization").(bool) && d.HasChanges(names.AttrAllocatedStorage, names.AttrIOPS, names.AttrStorageType, "storage_throughput") {
+		if _, err := waitDBInstanceStorageOptimized(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) storage optimization: %s", d.Get(names.AttrIdentifier).(string), err)
+		}
+	}
+
 	return append(diags, resourceInstanceRead(ctx, d, meta)...)
 }
 
@@ -2384,6 +2398,7 @@ func resourceInstanceImport(_ context.Context, d *schema.ResourceData, meta inte
 	// that final_snapshot_identifier is not required.
 	d.Set("skip_final_snapshot", true)
 	d.Set("delete_automated_backups", true)
+	d.Set("wait_for_storage_optimization", false)
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -2867,6 +2882,36 @@ func waitDBInstanceAvailable(ctx context.Context, conn *rds.Client, id string, t
 	return nil, err
 }
 
+// waitDBInstanceStorageOptimized waits for a DB instance to finish optimizing storage after a storage change.
+// Unlike waitDBInstanceAvailable, storage-optimization is a pending state.
+func waitDBInstanceStorageOptimized(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			instanceStatusBackingUp,
+			instanceStatusConfiguringEnhancedMonitoring,
+			instanceStatusConfiguringIAMDatabaseAuth,
+			instanceStatusConfiguringLogExports,
+			instanceStatusMaintenance,
+			instanceStatusModifying,
+			instanceStatusStorageOptimization,
+			instanceStatusUpgrading,
+		},
+		Target:                    []string{instanceStatusAvailable},
+		Refresh:                   statusDBInstance(ctx, conn, id),
+		Timeout:                   timeout,
+		PollInterval:              1 * time.Minute,
+		ContinuousTargetOccurence: 3,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.DBInstance); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitDBInstanceStopped(ctx context.Context, conn *rds.Client, id string, timeout time.Duration) (*types.DBInstance, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{
@@ -6437,6 +6437,50 @@ func TestAccRDSInstance_Storage_changeIOPSGP3(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_Storage_waitForStorageOptimization(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v types.DBInstance
+	resourceName := "aws_db_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDBInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_Storage_waitForStorageOptimization(rName, "gp2", 400),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStorageType, "gp2"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_storage_optimization", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_Storage_waitForStorageOptimization(rName, "gp3", 400),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStorageType, "gp3"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "available"),
+				),
+			},
+			{
+				// Storage changes are rejected while the previous change is being optimized.
+				Config: testAccInstanceConfig_Storage_waitForStorageOptimization(rName, "gp3", 500),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDBInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAllocatedStorage, "500"),
+				),
+			},
+		},
+	})
+}
+
 // https://github.com/hashicorp/terraform-provider-aws/issues/33512
 func TestAccRDSInstance_Storage_throughputSSE(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -12930,6 +12974,34 @@ resource "aws_db_instance" "test" {
 `, rName, tfrds.InstanceEngineSQLServerExpress, allocatedStorage))
 }
 
+func testAccInstanceConfig_Storage_waitForStorageOptimization(rName, storageType string, allocatedStorage int) string {
+	return acctest.ConfigCompose(
+		testAccInstanceConfig_orderableClassMySQL(),
+		fmt.Sprintf(`
+resource "aws_db_instance" "test" {
+  identifier           = %[1]q
+  engine               = data.aws_rds_engine_version.default.engine
+  engine_version       = data.aws_rds_engine_version.default.version
+  instance_class       = data.aws_rds_orderable_db_instance.test.instance_class
+  db_name              = "test"
+  password             = "avoid-plaintext-passwords"
+  username             = "tfacctest"
+  parameter_group_name = "default.${data.aws_rds_engine_version.default.parameter_group_family}"
+  skip_final_snapshot  = true
+
+  apply_immediately             = true
+  wait_for_storage_optimization = true
+
+  storage_type      = %[2]q
+  allocated_storage = %[3]d
+
+  timeouts {
+    update = "6h"
+  }
+}
+`, rName, storageType, allocatedStorage))
+}
+
 func testAccInstanceConfig_Storage_iopsThroughputMySQLGP3(rName string, iops, throughput int) string {
 	return acctest.ConfigCompose(
 		testAccInstanceConfig_orderableClassMySQLGP3(),
@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newGlobalClusterSwitchoverResource,
+			Name:    "Global Cluster Switchover",
+		},
 		{
 			Factory: newIntegrationResource,
 			Name:    "Integration",
@@ -7,19 +7,25 @@ import (
 	"context"
 	"log"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKDataSource("aws_s3_bucket", name="Bucket")
 func dataSourceBucket() *schema.Resource {
+	bucketSchema := resourceBucket().SchemaMap()
+
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceBucketRead,
 
@@ -44,10 +50,43 @@ func dataSourceBucket() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"include_configuration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"lifecycle_rule": sdkv2.DataSourcePropertyFromResourceProperty(bucketSchema["lifecycle_rule"]),
+			"public_access_block": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"block_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"block_public_policy": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ignore_public_acls": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"restrict_public_buckets": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrRegion: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"replication_configuration":            sdkv2.DataSourcePropertyFromResourceProperty(bucketSchema["replication_configuration"]),
+			"server_side_encryption_configuration": sdkv2.DataSourcePropertyFromResourceProperty(bucketSchema["server_side_encryption_configuration"]),
+			"versioning":                           sdkv2.DataSourcePropertyFromResourceProperty(bucketSchema["versioning"]),
 			"website_domain": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -112,5 +151,116 @@ func dataSourceBucketRead(ctx context.Context, d *schema.ResourceData, meta inte
 		log.Printf("[WARN] Reading S3 Bucket (%s) Website: %s", bucket, err)
 	}
 
+	if d.Get("include_configuration").(bool) {
+		return append(diags, dataSourceBucketReadConfiguration(ctx, d, conn, bucket)...)
+	}
+
+	return diags
+}
+
+// dataSourceBucketReadConfiguration reads the bucket's sub-resource configuration.
+// Configuration that is not set, or not supported by the bucket, is left empty.
+func dataSourceBucketReadConfiguration(ctx context.Context, d *schema.ResourceData, conn *s3.Client, bucket string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	isNotConfigured := func(err error) bool {
+		return tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeMethodNotAllowed, errCodeNotImplemented, errCodeXNotImplemented, errCodeUnsupportedOperation)
+	}
+
+	//
+	// Bucket Versioning.
+	//
+	bucketVersioning, err := findBucketVersioning(ctx, conn, bucket, "")
+
+	switch {
+	case err == nil:
+		if err := d.Set("versioning", flattenBucketVersioning(bucketVersioning)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting versioning: %s", err)
+		}
+	case isNotConfigured(err):
+		d.Set("versioning", nil)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) versioning: %s", bucket, err)
+	}
+
+	//
+	// Bucket Server-side Encryption Configuration.
+	//
+	encryptionConfiguration, err := findServerSideEncryptionConfiguration(ctx, conn, bucket, "")
+
+	switch {
+	case err == nil:
+		if err := d.Set("server_side_encryption_configuration", flattenBucketServerSideEncryptionConfiguration(encryptionConfiguration)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting server_side_encryption_configuration: %s", err)
+		}
+	case isNotConfigured(err):
+		d.Set("server_side_encryption_configuration", nil)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) server-side encryption configuration: %s", bucket, err)
+	}
+
+	//
+	// Bucket Lifecycle Configuration.
+	//
+	lifecycleConfiguration, err := findBucketLifecycleConfiguration(ctx, conn, bucket, "")
+
+	switch {
+	case err == nil:
+		if err := d.Set("lifecycle_rule", flattenBucketLifecycleRules(ctx, lifecycleConfiguration.Rules)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting lifecycle_rule: %s", err)
+		}
+	case isNotConfigured(err):
+		d.Set("lifecycle_rule", nil)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) lifecycle configuration: %s", bucket, err)
+	}
+
+	//
+	// Bucket Replication Configuration.
+	//
+	replicationConfiguration, err := findReplicationConfiguration(ctx, conn, bucket)
+
+	switch {
+	case err == nil:
+		if err := d.Set("replication_configuration", flattenBucketReplicationConfiguration(ctx, replicationConfiguration)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting replication_configuration: %s", err)
+		}
+	case isNotConfigured(err):
+		d.Set("replication_configuration", nil)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) replication configuration: %s", bucket, err)
+	}
+
+	//
+	// Bucket Public Access Block.
+	//
+	publicAccessBlockConfiguration, err := findPublicAccessBlockConfiguration(ctx, conn, bucket)
+
+	switch {
+	case err == nil:
+		if err := d.Set("public_access_block", flattenBucketPublicAccessBlockConfiguration(publicAccessBlockConfiguration)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting public_access_block: %s", err)
+		}
+	case isNotConfigured(err):
+		d.Set("public_access_block", nil)
+	default:
+		return sdkdiag.AppendErrorf(diags, "reading S3 Bucket (%s) public access block: %s", bucket, err)
+	}
+
 	return diags
 }
+
+func flattenBucketPublicAccessBlockConfiguration(apiObject *types.PublicAccessBlockConfiguration) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	tfMap := map[string]interface{}{
+		"block_public_acls":       aws.ToBool(apiObject.BlockPublicAcls),
+		"block_public_policy":     aws.ToBool(apiObject.BlockPublicPolicy),
+		"ignore_public_acls":      aws.ToBool(apiObject.IgnorePublicAcls),
+		"restrict_public_buckets": aws.ToBool(apiObject.RestrictPublicBuckets),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -107,6 +107,42 @@ func TestAccS3BucketDataSource_accessPointAlias(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketDataSource_includeConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketDataSourceConfig_includeConfiguration(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "include_configuration", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.expiration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.expiration.0.days", "30"),
+					resource.TestCheckResourceAttr(dataSourceName, "lifecycle_rule.0.id", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_acls", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.block_public_policy", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.ignore_public_acls", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "public_access_block.0.restrict_public_buckets", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "replication_configuration.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm", "AES256"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "versioning.0.mfa_delete", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func testAccBucketDataSourceConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -191,3 +227,52 @@ data "aws_s3_bucket" "test" {
 }
 `, rName)
 }
+
+func testAccBucketDataSourceConfig_includeConfiguration(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_versioning" "test" {
+  bucket = aws_s3_bucket.test.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    expiration {
+      days = 30
+    }
+  }
+}
+
+resource "aws_s3_bucket_public_access_block" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  block_public_acls       = true
+  block_public_policy     = true
+  ignore_public_acls      = true
+  restrict_public_buckets = true
+}
+
+data "aws_s3_bucket" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  include_configuration = true
+
+  depends_on = [
+    aws_s3_bucket_versioning.test,
+    aws_s3_bucket_lifecycle_configuration.test,
+    aws_s3_bucket_public_access_block.test,
+  ]
+}
+`, rName)
+}
@@ -0,0 +1,540 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	directoryBucketLifecycleConfigurationTimeout = 3 * time.Minute
+)
+
+// @FrameworkResource("aws_s3_directory_bucket_lifecycle_configuration", name="Directory Bucket Lifecycle Configuration")
+func newDirectoryBucketLifecycleConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directoryBucketLifecycleConfigurationResource{}
+
+	return r, nil
+}
+
+type directoryBucketLifecycleConfigurationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *directoryBucketLifecycleConfigurationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3_directory_bucket_lifecycle_configuration"
+}
+
+func (r *directoryBucketLifecycleConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(directoryBucketNameRegex, `must be in the format [bucket_name]--[azid]--x-s3. Use the aws_s3_bucket_lifecycle_configuration resource to manage general purpose buckets`),
+				},
+			},
+			names.AttrExpectedBucketOwner: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[directoryBucketLifecycleRuleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1000),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrID: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 255),
+							},
+						},
+						names.AttrStatus: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ExpirationStatus](),
+							Required:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"abort_incomplete_multipart_upload": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[directoryBucketAbortIncompleteMultipartUploadModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"days_after_initiation": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+							},
+						},
+						"expiration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[directoryBucketLifecycleExpirationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"days": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+							},
+						},
+						names.AttrFilter: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[directoryBucketLifecycleRuleFilterModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"object_size_greater_than": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(0),
+										},
+									},
+									"object_size_less_than": schema.Int64Attribute{
+										Optional: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									names.AttrPrefix: schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+								},
+								Validators: []validator.Object{
+									objectvalidator.AtLeastOneOf(
+										path.MatchRelative().AtName("object_size_greater_than"),
+										path.MatchRelative().AtName("object_size_less_than"),
+										path.MatchRelative().AtName(names.AttrPrefix),
+									),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *directoryBucketLifecycleConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directoryBucketLifecycleConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := data.expandRules(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ExpressClient(ctx)
+
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	if err := putDirectoryBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner, rules, errCodeNoSuchBucket); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Bucket (%s) Lifecycle Configuration", bucket), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	if _, err := waitLifecycleRulesEquals(ctx, conn, bucket, expectedBucketOwner, rules, directoryBucketLifecycleConfigurationTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Directory Bucket Lifecycle Configuration (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directoryBucketLifecycleConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directoryBucketLifecycleConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().S3ExpressClient(ctx)
+
+	output, err := findBucketLifecycleConfiguration(ctx, conn, data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Bucket Lifecycle Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenRules(ctx, output.Rules)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directoryBucketLifecycleConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new directoryBucketLifecycleConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := new.expandRules(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ExpressClient(ctx)
+
+	bucket, expectedBucketOwner := new.Bucket.ValueString(), new.ExpectedBucketOwner.ValueString()
+	if err := putDirectoryBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner, rules, errCodeNoSuchLifecycleConfiguration); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Bucket Lifecycle Configuration (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitLifecycleRulesEquals(ctx, conn, bucket, expectedBucketOwner, rules, directoryBucketLifecycleConfigurationTimeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Directory Bucket Lifecycle Configuration (%s) update", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directoryBucketLifecycleConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directoryBucketLifecycleConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3ExpressClient(ctx)
+
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	input := &s3.DeleteBucketLifecycleInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := conn.DeleteBucketLifecycle(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeNoSuchLifecycleConfiguration) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Bucket Lifecycle Configuration (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Directory Bucket Lifecycle Configuration (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func putDirectoryBucketLifecycleConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, rules []awstypes.LifecycleRule, retryErrCode string) error {
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: aws.String(bucket),
+		LifecycleConfiguration: &awstypes.BucketLifecycleConfiguration{
+			Rules: rules,
+		},
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
+		return conn.PutBucketLifecycleConfiguration(ctx, input)
+	}, retryErrCode)
+
+	return err
+}
+
+type directoryBucketLifecycleConfigurationResourceModel struct {
+	Bucket              types.String                                                       `tfsdk:"bucket"`
+	ExpectedBucketOwner types.String                                                       `tfsdk:"expected_bucket_owner"`
+	ID                  types.String                                                       `tfsdk:"id"`
+	Rules               fwtypes.ListNestedObjectValueOf[directoryBucketLifecycleRuleModel] `tfsdk:"rule"`
+}
+
+func (data *directoryBucketLifecycleConfigurationResourceModel) InitFromID() error {
+	bucket, expectedBucketOwner, err := ParseResourceID(data.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.Bucket = types.StringValue(bucket)
+	data.ExpectedBucketOwner = fwflex.EmptyStringAsNull(types.StringValue(expectedBucketOwner))
+
+	return nil
+}
+
+func (data *directoryBucketLifecycleConfigurationResourceModel) setID() {
+	data.ID = types.StringValue(CreateResourceID(data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()))
+}
+
+func (data *directoryBucketLifecycleConfigurationResourceModel) expandRules(ctx context.Context) ([]awstypes.LifecycleRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfRules, d := data.Rules.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	apiObjects := make([]awstypes.LifecycleRule, 0, len(tfRules))
+
+	for _, tfRule := range tfRules {
+		apiObject := awstypes.LifecycleRule{
+			ID:     fwflex.StringFromFramework(ctx, tfRule.ID),
+			Status: tfRule.Status.ValueEnum(),
+		}
+
+		abortIncompleteMultipartUpload, d := tfRule.AbortIncompleteMultipartUpload.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if abortIncompleteMultipartUpload != nil {
+			apiObject.AbortIncompleteMultipartUpload = &awstypes.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: fwflex.Int32FromFramework(ctx, abortIncompleteMultipartUpload.DaysAfterInitiation),
+			}
+		}
+
+		expiration, d := tfRule.Expiration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if expiration != nil {
+			apiObject.Expiration = &awstypes.LifecycleExpiration{
+				Days: fwflex.Int32FromFramework(ctx, expiration.Days),
+			}
+		}
+
+		filter, d := tfRule.Filter.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		apiObject.Filter = filter.expand(ctx)
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func (data *directoryBucketLifecycleConfigurationResourceModel) flattenRules(ctx context.Context, apiObjects []awstypes.LifecycleRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfRules := make([]*directoryBucketLifecycleRuleModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfRule := &directoryBucketLifecycleRuleModel{
+			AbortIncompleteMultipartUpload: fwtypes.NewListNestedObjectValueOfNull[directoryBucketAbortIncompleteMultipartUploadModel](ctx),
+			Expiration:                     fwtypes.NewListNestedObjectValueOfNull[directoryBucketLifecycleExpirationModel](ctx),
+			Filter:                         fwtypes.NewListNestedObjectValueOfNull[directoryBucketLifecycleRuleFilterModel](ctx),
+			ID:                             fwflex.StringToFramework(ctx, apiObject.ID),
+			Status:                         fwtypes.StringEnumValue(apiObject.Status),
+		}
+
+		if v := apiObject.AbortIncompleteMultipartUpload; v != nil {
+			tfRule.AbortIncompleteMultipartUpload = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &directoryBucketAbortIncompleteMultipartUploadModel{
+				DaysAfterInitiation: fwflex.Int32ToFramework(ctx, v.DaysAfterInitiation),
+			})
+		}
+
+		if v := apiObject.Expiration; v != nil {
+			tfRule.Expiration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &directoryBucketLifecycleExpirationModel{
+				Days: fwflex.Int32ToFramework(ctx, v.Days),
+			})
+		}
+
+		if v := flattenDirectoryBucketLifecycleRuleFilter(ctx, apiObject.Filter); v != nil {
+			tfRule.Filter = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, v)
+		}
+
+		tfRules = append(tfRules, tfRule)
+	}
+
+	data.Rules, diags = fwtypes.NewListNestedObjectValueOfSlice(ctx, tfRules)
+
+	return diags
+}
+
+type directoryBucketLifecycleRuleModel struct {
+	AbortIncompleteMultipartUpload fwtypes.ListNestedObjectValueOf[directoryBucketAbortIncompleteMultipartUploadModel] `tfsdk:"abort_incomplete_multipart_upload"`
+	Expiration                     fwtypes.ListNestedObjectValueOf[directoryBucketLifecycleExpirationModel]            `tfsdk:"expiration"`
+	Filter                         fwtypes.ListNestedObjectValueOf[directoryBucketLifecycleRuleFilterModel]            `tfsdk:"filter"`
+	ID                             types.String                                                                        `tfsdk:"id"`
+	Status                         fwtypes.StringEnum[awstypes.ExpirationStatus]                                       `tfsdk:"status"`
+}
+
+type directoryBucketAbortIncompleteMultipartUploadModel struct {
+	DaysAfterInitiation types.Int64 `tfsdk:"days_after_initiation"`
+}
+
+type directoryBucketLifecycleExpirationModel struct {
+	Days types.Int64 `tfsdk:"days"`
+}
+
+type directoryBucketLifecycleRuleFilterModel struct {
+	ObjectSizeGreaterThan types.Int64  `tfsdk:"object_size_greater_than"`
+	ObjectSizeLessThan    types.Int64  `tfsdk:"object_size_less_than"`
+	Prefix                types.String `tfsdk:"prefix"`
+}
+
+// expand returns the API filter for the rule.
+// A filter must have exactly one of Prefix, ObjectSizeGreaterThan, ObjectSizeLessThan or And specified,
+// so multiple conditions are combined with And. An omitted filter applies the rule to all objects in the bucket.
+func (m *directoryBucketLifecycleRuleFilterModel) expand(ctx context.Context) *awstypes.LifecycleRuleFilter {
+	if m == nil {
+		return &awstypes.LifecycleRuleFilter{
+			Prefix: aws.String(""),
+		}
+	}
+
+	prefix := fwflex.StringFromFramework(ctx, m.Prefix)
+	objectSizeGreaterThan := fwflex.Int64FromFramework(ctx, m.ObjectSizeGreaterThan)
+	objectSizeLessThan := fwflex.Int64FromFramework(ctx, m.ObjectSizeLessThan)
+
+	n := 0
+	for _, set := range []bool{prefix != nil, objectSizeGreaterThan != nil, objectSizeLessThan != nil} {
+		if set {
+			n++
+		}
+	}
+
+	switch {
+	case n > 1:
+		return &awstypes.LifecycleRuleFilter{
+			And: &awstypes.LifecycleRuleAndOperator{
+				ObjectSizeGreaterThan: objectSizeGreaterThan,
+				ObjectSizeLessThan:    objectSizeLessThan,
+				Prefix:                prefix,
+			},
+		}
+	case objectSizeGreaterThan != nil:
+		return &awstypes.LifecycleRuleFilter{
+			ObjectSizeGreaterThan: objectSizeGreaterThan,
+		}
+	case objectSizeLessThan != nil:
+		return &awstypes.LifecycleRuleFilter{
+			ObjectSizeLessThan: objectSizeLessThan,
+		}
+	default:
+		return &awstypes.LifecycleRuleFilter{
+			Prefix: aws.String(aws.ToString(prefix)),
+		}
+	}
+}
+
+// flattenDirectoryBucketLifecycleRuleFilter returns nil for a filter that matches all objects in the bucket.
+func flattenDirectoryBucketLifecycleRuleFilter(ctx context.Context, apiObject *awstypes.LifecycleRuleFilter) *directoryBucketLifecycleRuleFilterModel {
+	if apiObject == nil {
+		return nil
+	}
+
+	var prefix *string
+	var objectSizeGreaterThan, objectSizeLessThan *int64
+
+	if v := apiObject.And; v != nil {
+		prefix, objectSizeGreaterThan, objectSizeLessThan = v.Prefix, v.ObjectSizeGreaterThan, v.ObjectSizeLessThan
+	} else {
+		prefix, objectSizeGreaterThan, objectSizeLessThan = apiObject.Prefix, apiObject.ObjectSizeGreaterThan, apiObject.ObjectSizeLessThan
+	}
+
+	if aws.ToString(prefix) == "" && objectSizeGreaterThan == nil && objectSizeLessThan == nil {
+		return nil
+	}
+
+	return &directoryBucketLifecycleRuleFilterModel{
+		ObjectSizeGreaterThan: fwflex.Int64ToFramework(ctx, objectSizeGreaterThan),
+		ObjectSizeLessThan:    fwflex.Int64ToFramework(ctx, objectSizeLessThan),
+		Prefix:                fwflex.EmptyStringAsNull(fwflex.StringToFramework(ctx, prefix)),
+	}
+}
@@ -26,7 +26,9 @@ var (
 	ResourceBucketVersioning                        = resourceBucketVersioning
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
+	ResourceDirectoryBucketLifecycleConfiguration   = newDirectoryBucketLifecycleConfigurationResource
 	ResourceObjectCopy                              = resourceObjectCopy
+	ResourceObjectDirectory                         = resourceObjectDirectory
 
 	BucketUpdateTags                      = bucketUpdateTags
 	BucketRegionalDomainName              = bucketRegionalDomainName
@@ -56,6 +58,8 @@ var (
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	ObjectDirectoryFileHashAndContentType = objectDirectoryFileHashAndContentType
+	ObjectDirectoryFiles                  = objectDirectoryFiles
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
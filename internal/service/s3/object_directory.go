@@ -0,0 +1,604 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"maps"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+const (
+	objectDirectoryDefaultContentType = "binary/octet-stream"
+)
+
+// @SDKResource("aws_s3_object_directory", name="Object Directory")
+func resourceObjectDirectory() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceObjectDirectoryCreate,
+		ReadWithoutTimeout:   resourceObjectDirectoryRead,
+		UpdateWithoutTimeout: resourceObjectDirectoryUpdate,
+		DeleteWithoutTimeout: resourceObjectDirectoryDelete,
+
+		CustomizeDiff: resourceObjectDirectoryCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrBucket: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"cache_control": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"etags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"exclude": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+			"key_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					return sdkv1CompatibleCleanKey(v.(string))
+				},
+			},
+			names.AttrKMSKeyID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"server_side_encryption": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ServerSideEncryption](),
+			},
+			names.AttrSource: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"source_hashes": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrStorageClass: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[types.ObjectStorageClass](),
+			},
+		},
+	}
+}
+
+func resourceObjectDirectoryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	keyPrefix := sdkv1CompatibleCleanKey(d.Get("key_prefix").(string))
+
+	files, err := readObjectDirectorySource(d)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	etags, err := uploadObjectDirectoryFiles(ctx, conn, d, slices.Collect(maps.Values(files)))
+
+	// Record whatever was uploaded so that a partial failure can be retried and cleaned up.
+	d.SetId(objectDirectoryCreateResourceID(bucket, keyPrefix))
+	d.Set("etags", etags)
+	d.Set("source_hashes", objectDirectorySourceHashes(files, etags))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "uploading S3 Object Directory (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceObjectDirectoryRead(ctx, d, meta)...)
+}
+
+func resourceObjectDirectoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+	keyPrefix := sdkv1CompatibleCleanKey(d.Get("key_prefix").(string))
+
+	remoteETags, err := findObjectETagsByPrefix(ctx, conn, bucket, keyPrefix)
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Object Directory (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading S3 Object Directory (%s): %s", d.Id(), err)
+	}
+
+	etags := flex.ExpandStringValueMap(d.Get("etags").(map[string]interface{}))
+	sourceHashes := flex.ExpandStringValueMap(d.Get("source_hashes").(map[string]interface{}))
+
+	// Objects that were deleted or overwritten outside of Terraform are dropped from the source hashes,
+	// so that the next plan uploads them again.
+	for key, etag := range etags {
+		remoteETag, ok := remoteETags[key]
+
+		if !ok {
+			delete(etags, key)
+			delete(sourceHashes, key)
+			continue
+		}
+
+		if remoteETag != etag {
+			etags[key] = remoteETag
+			delete(sourceHashes, key)
+		}
+	}
+
+	d.Set("etags", etags)
+	d.Set("key_prefix", keyPrefix)
+	d.Set("source_hashes", sourceHashes)
+
+	return diags
+}
+
+func resourceObjectDirectoryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	files, err := readObjectDirectorySource(d)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// "etags" and "source_hashes" are unknown in the plan when there are changes to apply.
+	o, _ := d.GetChange("source_hashes")
+	oldSourceHashes := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	o, _ = d.GetChange("etags")
+	oldETags := flex.ExpandStringValueMap(o.(map[string]interface{}))
+	uploadAll := d.HasChanges("cache_control", names.AttrKMSKeyID, "server_side_encryption", names.AttrStorageClass)
+
+	var toUpload []objectDirectoryFile
+	for key, file := range files {
+		if uploadAll || oldSourceHashes[key] != file.hash {
+			toUpload = append(toUpload, file)
+		}
+	}
+
+	var toDelete []string
+	for key := range oldETags {
+		if _, ok := files[key]; !ok {
+			toDelete = append(toDelete, key)
+		}
+	}
+
+	uploaded, uploadErr := uploadObjectDirectoryFiles(ctx, conn, d, toUpload)
+
+	deleted, deleteErr := deleteObjectDirectoryObjects(ctx, conn, bucket, toDelete)
+
+	// Objects that failed to upload or delete keep their previous state so that the next apply retries them.
+	etags := oldETags
+	maps.Copy(etags, uploaded)
+	for _, key := range deleted {
+		delete(etags, key)
+	}
+
+	sourceHashes := objectDirectorySourceHashes(files, uploaded)
+	for key := range etags {
+		if _, ok := uploaded[key]; ok {
+			continue
+		}
+		if v, ok := oldSourceHashes[key]; ok {
+			sourceHashes[key] = v
+		}
+	}
+
+	d.Set("etags", etags)
+	d.Set("source_hashes", sourceHashes)
+
+	if err := errors.Join(uploadErr, deleteErr); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating S3 Object Directory (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceObjectDirectoryRead(ctx, d, meta)...)
+}
+
+func resourceObjectDirectoryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).S3Client(ctx)
+
+	bucket := d.Get(names.AttrBucket).(string)
+	if isDirectoryBucket(bucket) {
+		conn = meta.(*conns.AWSClient).S3ExpressClient(ctx)
+	}
+
+	keys := slices.Collect(maps.Keys(d.Get("etags").(map[string]interface{})))
+
+	if _, err := deleteObjectDirectoryObjects(ctx, conn, bucket, keys); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting S3 Object Directory (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceObjectDirectoryCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(names.AttrSource) || !d.NewValueKnown("exclude") || !d.NewValueKnown("key_prefix") {
+		return d.SetNewComputed("source_hashes")
+	}
+
+	files, err := readObjectDirectorySource(d)
+	if err != nil {
+		return err
+	}
+
+	sourceHashes := make(map[string]string, len(files))
+	for key, file := range files {
+		sourceHashes[key] = file.hash
+	}
+
+	if o := flex.ExpandStringValueMap(d.Get("source_hashes").(map[string]interface{})); !maps.Equal(o, sourceHashes) {
+		if err := d.SetNew("source_hashes", sourceHashes); err != nil {
+			return err
+		}
+
+		return d.SetNewComputed("etags")
+	}
+
+	if d.HasChanges("cache_control", names.AttrKMSKeyID, "server_side_encryption", names.AttrStorageClass) {
+		return d.SetNewComputed("etags")
+	}
+
+	return nil
+}
+
+func objectDirectoryCreateResourceID(bucket, keyPrefix string) string {
+	return bucket + "/" + keyPrefix
+}
+
+type objectDirectoryFile struct {
+	contentType string
+	hash        string
+	key         string
+	path        string
+}
+
+type resourceGetter interface {
+	Get(string) any
+}
+
+func readObjectDirectorySource(d resourceGetter) (map[string]objectDirectoryFile, error) {
+	source := d.Get(names.AttrSource).(string)
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+	}
+
+	var excludes []string
+	if v, ok := d.Get("exclude").(*schema.Set); ok {
+		excludes = flex.ExpandStringValueSet(v)
+	}
+
+	return objectDirectoryFiles(path, sdkv1CompatibleCleanKey(d.Get("key_prefix").(string)), excludes)
+}
+
+// objectDirectoryFiles returns the regular files under root, keyed by object key.
+// Files whose slash-separated path relative to root matches one of the exclude patterns are skipped.
+func objectDirectoryFiles(root, keyPrefix string, excludes []string) (map[string]objectDirectoryFile, error) {
+	files := make(map[string]objectDirectoryFile)
+
+	err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		for _, pattern := range excludes {
+			if matched, err := filepath.Match(pattern, rel); err != nil {
+				return fmt.Errorf("exclude pattern (%s): %w", pattern, err)
+			} else if matched {
+				return nil
+			}
+		}
+
+		// Follow symbolic links to files but skip anything else that isn't a regular file.
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		hash, contentType, err := objectDirectoryFileHashAndContentType(path)
+		if err != nil {
+			return err
+		}
+
+		key := keyPrefix + rel
+		files[key] = objectDirectoryFile{
+			contentType: contentType,
+			hash:        hash,
+			key:         key,
+			path:        path,
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading S3 object directory source (%s): %w", root, err)
+	}
+
+	return files, nil
+}
+
+// objectDirectoryFileHashAndContentType returns the SHA-256 hash and content type of the specified file.
+// The content type is derived from the file extension, falling back to content sniffing.
+func objectDirectoryFileHashAndContentType(path string) (string, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+
+	h := sha256.New()
+	r := io.TeeReader(file, h)
+
+	if contentType == "" {
+		buf := make([]byte, 512)
+		n, err := io.ReadFull(r, buf)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return "", "", err
+		}
+		if n > 0 {
+			contentType = http.DetectContentType(buf[:n])
+		} else {
+			contentType = objectDirectoryDefaultContentType
+		}
+	}
+
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return "", "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), contentType, nil
+}
+
+// objectDirectorySourceHashes returns the source hashes of the files that have been uploaded.
+func objectDirectorySourceHashes(files map[string]objectDirectoryFile, etags map[string]string) map[string]string {
+	sourceHashes := make(map[string]string, len(etags))
+
+	for key := range etags {
+		if file, ok := files[key]; ok {
+			sourceHashes[key] = file.hash
+		}
+	}
+
+	return sourceHashes
+}
+
+// uploadObjectDirectoryFiles uploads files in parallel, returning the ETags of the objects successfully uploaded.
+func uploadObjectDirectoryFiles(ctx context.Context, conn *s3.Client, d *schema.ResourceData, files []objectDirectoryFile) (map[string]string, error) {
+	// Object settings shared by all files. ResourceData is not safe for concurrent use.
+	template := s3.PutObjectInput{
+		Bucket: aws.String(d.Get(names.AttrBucket).(string)),
+	}
+
+	if v, ok := d.GetOk("cache_control"); ok {
+		template.CacheControl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKMSKeyID); ok {
+		template.SSEKMSKeyId = aws.String(v.(string))
+		template.ServerSideEncryption = types.ServerSideEncryptionAwsKms
+	}
+
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		template.ServerSideEncryption = types.ServerSideEncryption(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrStorageClass); ok {
+		template.StorageClass = types.StorageClass(v.(string))
+	}
+
+	uploader := manager.NewUploader(conn)
+
+	var (
+		mu    sync.Mutex
+		wg    sync.WaitGroup
+		errs  []error
+		etags = make(map[string]string, len(files))
+	)
+	sem := make(chan struct{}, d.Get("max_concurrency").(int))
+
+	for _, file := range files {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			etag, err := uploadObjectDirectoryFile(ctx, uploader, template, file)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("uploading S3 Object (%s) from (%s): %w", file.key, file.path, err))
+				return
+			}
+
+			etags[file.key] = etag
+		}()
+	}
+
+	wg.Wait()
+
+	return etags, errors.Join(errs...)
+}
+
+func uploadObjectDirectoryFile(ctx context.Context, uploader *manager.Uploader, input s3.PutObjectInput, file objectDirectoryFile) (string, error) {
+	body, err := os.Open(file.path)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	input.Body = body
+	input.ContentType = aws.String(file.contentType)
+	input.Key = aws.String(file.key)
+
+	output, err := uploader.Upload(ctx, &input)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Trim(aws.ToString(output.ETag), `"`), nil
+}
+
+// deleteObjectDirectoryObjects deletes the specified objects, returning the keys of the objects successfully deleted.
+func deleteObjectDirectoryObjects(ctx context.Context, conn *s3.Client, bucket string, keys []string) ([]string, error) {
+	const (
+		maxObjectsPerRequest = 1000
+	)
+	var deleted []string
+	var errs []error
+
+	for chunk := range slices.Chunk(keys, maxObjectsPerRequest) {
+		input := &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &types.Delete{
+				Objects: tfslices.ApplyToAll(chunk, func(key string) types.ObjectIdentifier {
+					return types.ObjectIdentifier{
+						Key: aws.String(key),
+					}
+				}),
+				Quiet: aws.Bool(true), // Only report errors.
+			},
+		}
+
+		output, err := conn.DeleteObjects(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return keys, nil
+		}
+
+		if err != nil {
+			return deleted, fmt.Errorf("deleting S3 bucket (%s) objects: %w", bucket, err)
+		}
+
+		failed := make(map[string]struct{}, len(output.Errors))
+		for _, v := range output.Errors {
+			failed[aws.ToString(v.Key)] = struct{}{}
+			errs = append(errs, newDeleteObjectVersionError(v))
+		}
+
+		for _, key := range chunk {
+			if _, ok := failed[key]; !ok {
+				deleted = append(deleted, key)
+			}
+		}
+	}
+
+	return deleted, errors.Join(errs...)
+}
+
+// findObjectETagsByPrefix returns the ETags of all objects whose keys begin with the specified prefix.
+func findObjectETagsByPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	etags := make(map[string]string)
+
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			etags[aws.ToString(v.Key)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return etags, nil
+}
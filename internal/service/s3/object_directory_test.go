@@ -0,0 +1,387 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestObjectDirectoryFiles(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	testAccObjectDirectoryWriteFiles(t, root, map[string]string{
+		"index.html":          "<html></html>",
+		"css/site.css":        "body {}",
+		"data/blob":           "\x00\x01\x02",
+		"logs/debug.log":      "debug",
+		"nested/deep/a.json":  "{}",
+		"nested/deep/b.txt":   "b",
+		"nested/deep/c.log":   "c",
+		"nested/skip/me.html": "me",
+	})
+
+	testCases := []struct {
+		name      string
+		keyPrefix string
+		excludes  []string
+		want      []string
+	}{
+		{
+			name: "all files",
+			want: []string{
+				"css/site.css",
+				"data/blob",
+				"index.html",
+				"logs/debug.log",
+				"nested/deep/a.json",
+				"nested/deep/b.txt",
+				"nested/deep/c.log",
+				"nested/skip/me.html",
+			},
+		},
+		{
+			name:      "key prefix",
+			keyPrefix: "site/",
+			excludes:  []string{"nested/*/*"},
+			want: []string{
+				"site/css/site.css",
+				"site/data/blob",
+				"site/index.html",
+				"site/logs/debug.log",
+			},
+		},
+		{
+			name:     "excludes",
+			excludes: []string{"logs/*", "nested/deep/*.log", "nested/skip/*"},
+			want: []string{
+				"css/site.css",
+				"data/blob",
+				"index.html",
+				"nested/deep/a.json",
+				"nested/deep/b.txt",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			files, err := tfs3.ObjectDirectoryFiles(root, testCase.keyPrefix, testCase.excludes)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(slices.Sorted(maps.Keys(files)), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+
+	if _, err := tfs3.ObjectDirectoryFiles(root, "", []string{"["}); err == nil {
+		t.Error("expected error for invalid exclude pattern, got none")
+	}
+
+	if _, err := tfs3.ObjectDirectoryFiles(filepath.Join(root, "missing"), "", nil); err == nil {
+		t.Error("expected error for missing source, got none")
+	}
+}
+
+func TestObjectDirectoryFileHashAndContentType(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	testAccObjectDirectoryWriteFiles(t, root, map[string]string{
+		"index.html": "<html></html>",
+		"copy.html":  "<html></html>",
+		"README":     "plain text",
+		"blob":       "\x00\x01\x02",
+		"empty":      "",
+	})
+
+	testCases := []struct {
+		name            string
+		wantContentType string
+	}{
+		{
+			name:            "index.html",
+			wantContentType: "text/html; charset=utf-8",
+		},
+		{
+			name:            "README",
+			wantContentType: "text/plain; charset=utf-8",
+		},
+		{
+			name:            "blob",
+			wantContentType: "application/octet-stream",
+		},
+		{
+			name:            "empty",
+			wantContentType: "binary/octet-stream",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, contentType, err := tfs3.ObjectDirectoryFileHashAndContentType(filepath.Join(root, testCase.name))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := contentType, testCase.wantContentType; got != want {
+				t.Errorf("content type = %q, want %q", got, want)
+			}
+		})
+	}
+
+	hash1, _, err := tfs3.ObjectDirectoryFileHashAndContentType(filepath.Join(root, "index.html"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	hash2, _, err := tfs3.ObjectDirectoryFileHashAndContentType(filepath.Join(root, "copy.html"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	hash3, _, err := tfs3.ObjectDirectoryFileHashAndContentType(filepath.Join(root, "README"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if hash1 != hash2 {
+		t.Errorf("expected identical content to have equal hashes, got %q and %q", hash1, hash2)
+	}
+	if hash1 == hash3 {
+		t.Errorf("expected different content to have different hashes, got %q", hash1)
+	}
+}
+
+func TestAccS3ObjectDirectory_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_directory.test"
+	source := t.TempDir()
+
+	testAccObjectDirectoryWriteFiles(t, source, map[string]string{
+		"index.html":   "<html></html>",
+		"css/site.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectDirectoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.css/site.css"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.index.html"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "source_hashes.%", "2"),
+					testAccCheckObjectDirectoryContentType(ctx, resourceName, "site/css/site.css", "text/css; charset=utf-8"),
+					testAccCheckObjectDirectoryContentType(ctx, resourceName, "site/index.html", "text/html; charset=utf-8"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccObjectDirectoryWriteFiles(t, source, map[string]string{
+						"index.html": "<html><body></body></html>",
+						"app.js":     "console.log(1)",
+					})
+					if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccObjectDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectDirectoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "etags.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.app.js"),
+					resource.TestCheckResourceAttrSet(resourceName, "etags.index.html"),
+					resource.TestCheckNoResourceAttr(resourceName, "etags.css/site.css"),
+					resource.TestCheckResourceAttr(resourceName, "source_hashes.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectDirectory_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_directory.test"
+	source := t.TempDir()
+
+	testAccObjectDirectoryWriteFiles(t, source, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDirectoryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectDirectoryExists(ctx, resourceName),
+					testAccCheckObjectDirectoryPutObject(ctx, resourceName, "site/index.html", "changed outside of Terraform"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccObjectDirectoryConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectDirectoryExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "source_hashes.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccObjectDirectoryWriteFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccCheckObjectDirectoryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_object_directory" {
+				continue
+			}
+
+			for key, value := range rs.Primary.Attributes {
+				if !strings.HasPrefix(key, "etags.") || key == "etags.%" {
+					continue
+				}
+
+				_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], strings.TrimPrefix(key, "etags."), value, "")
+
+				if err == nil {
+					return fmt.Errorf("S3 Object Directory %s object %s still exists", rs.Primary.ID, key)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectDirectoryExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for key, value := range rs.Primary.Attributes {
+			if !strings.HasPrefix(key, "etags.") || key == "etags.%" {
+				continue
+			}
+
+			if _, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], strings.TrimPrefix(key, "etags."), value, ""); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectDirectoryContentType(ctx context.Context, n, key, contentType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); got != contentType {
+			return fmt.Errorf("S3 Object (%s) content type = %q, want %q", key, got, contentType)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckObjectDirectoryPutObject(ctx context.Context, n, key, content string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := conn.PutObject(ctx, &s3.PutObjectInput{
+			Body:   strings.NewReader(content),
+			Bucket: aws.String(rs.Primary.Attributes[names.AttrBucket]),
+			Key:    aws.String(key),
+		})
+
+		return err
+	}
+}
+
+func testAccObjectDirectoryConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object_directory" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[2]q
+}
+`, rName, source)
+}
@@ -27,6 +27,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newDirectoryBucketResource,
 			Name:    "Directory Bucket",
 		},
+		{
+			Factory: newDirectoryBucketLifecycleConfigurationResource,
+			Name:    "Directory Bucket Lifecycle Configuration",
+		},
 	}
 }
 
@@ -211,6 +215,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "ObjectCopy",
 			},
 		},
+		{
+			Factory:  resourceObjectDirectory,
+			TypeName: "aws_s3_object_directory",
+			Name:     "Object Directory",
+		},
 	}
 }
 
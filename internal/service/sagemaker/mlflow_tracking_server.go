@@ -5,6 +5,7 @@ package sagemaker
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -45,6 +46,17 @@ func resourceMlflowTrackingServer() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validModelDataURL,
 			},
+			"automatic_model_registration": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"desired_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.IsTrackingServerActiveActive,
+				ValidateDiagFunc: enum.Validate[awstypes.IsTrackingServerActive](),
+			},
 			names.AttrRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -66,11 +78,6 @@ func resourceMlflowTrackingServer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"automatic_model_registration": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Default:  false,
-			},
 			"tracking_server_size": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -120,7 +127,13 @@ func resourceMlflowTrackingServerCreate(ctx context.Context, d *schema.ResourceD
 	d.SetId(name)
 
 	if _, err := waitMlflowTrackingServerCreated(ctx, conn, d.Id()); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Mlflow Tracking Server (%s) to delete: %s", d.Id(), err)
+		return sdkdiag.AppendErrorf(diags, "waiting for SageMaker Mlflow Tracking Server (%s) to create: %s", d.Id(), err)
+	}
+
+	if v := d.Get("desired_state").(string); v == string(awstypes.IsTrackingServerActiveInactive) {
+		if err := stopMlflowTrackingServer(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	return append(diags, resourceMlflowTrackingServerRead(ctx, d, meta)...)
@@ -151,6 +164,7 @@ func resourceMlflowTrackingServerRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("weekly_maintenance_window_start", output.WeeklyMaintenanceWindowStart)
 	d.Set("tracking_server_url", output.TrackingServerUrl)
 	d.Set("automatic_model_registration", output.AutomaticModelRegistration)
+	d.Set("desired_state", output.IsActive)
 
 	return diags
 }
@@ -159,7 +173,14 @@ func resourceMlflowTrackingServerUpdate(ctx context.Context, d *schema.ResourceD
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SageMakerClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	// A stopped tracking server is started before any other changes are applied.
+	if d.HasChange("desired_state") && d.Get("desired_state").(string) == string(awstypes.IsTrackingServerActiveActive) {
+		if err := startMlflowTrackingServer(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "desired_state") {
 		input := &sagemaker.UpdateMlflowTrackingServerInput{
 			TrackingServerName: aws.String(d.Id()),
 		}
@@ -171,9 +192,7 @@ func resourceMlflowTrackingServerUpdate(ctx context.Context, d *schema.ResourceD
 		}
 
 		if d.HasChange("automatic_model_registration") {
-			if v, ok := d.GetOk("automatic_model_registration"); ok {
-				input.AutomaticModelRegistration = aws.Bool(v.(bool))
-			}
+			input.AutomaticModelRegistration = aws.Bool(d.Get("automatic_model_registration").(bool))
 		}
 
 		if d.HasChange("tracking_server_size") {
@@ -199,6 +218,12 @@ func resourceMlflowTrackingServerUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
+	if d.HasChange("desired_state") && d.Get("desired_state").(string) == string(awstypes.IsTrackingServerActiveInactive) {
+		if err := stopMlflowTrackingServer(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceMlflowTrackingServerRead(ctx, d, meta)...)
 }
 
@@ -224,6 +249,38 @@ func resourceMlflowTrackingServerDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+func startMlflowTrackingServer(ctx context.Context, conn *sagemaker.Client, name string) error {
+	input := &sagemaker.StartMlflowTrackingServerInput{
+		TrackingServerName: aws.String(name),
+	}
+
+	if _, err := conn.StartMlflowTrackingServer(ctx, input); err != nil {
+		return fmt.Errorf("starting SageMaker Mlflow Tracking Server (%s): %w", name, err)
+	}
+
+	if _, err := waitMlflowTrackingServerStarted(ctx, conn, name); err != nil {
+		return fmt.Errorf("waiting for SageMaker Mlflow Tracking Server (%s) to start: %w", name, err)
+	}
+
+	return nil
+}
+
+func stopMlflowTrackingServer(ctx context.Context, conn *sagemaker.Client, name string) error {
+	input := &sagemaker.StopMlflowTrackingServerInput{
+		TrackingServerName: aws.String(name),
+	}
+
+	if _, err := conn.StopMlflowTrackingServer(ctx, input); err != nil {
+		return fmt.Errorf("stopping SageMaker Mlflow Tracking Server (%s): %w", name, err)
+	}
+
+	if _, err := waitMlflowTrackingServerStopped(ctx, conn, name); err != nil {
+		return fmt.Errorf("waiting for SageMaker Mlflow Tracking Server (%s) to stop: %w", name, err)
+	}
+
+	return nil
+}
+
 func findMlflowTrackingServerByName(ctx context.Context, conn *sagemaker.Client, name string) (*sagemaker.DescribeMlflowTrackingServerOutput, error) {
 	input := &sagemaker.DescribeMlflowTrackingServerInput{
 		TrackingServerName: aws.String(name),
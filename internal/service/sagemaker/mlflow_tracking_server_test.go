@@ -37,6 +37,7 @@ func TestAccSageMakerMlflowTrackingServer_basic(t *testing.T) {
 					testAccCheckMlflowTrackingServerExists(ctx, resourceName, &mpg),
 					resource.TestCheckResourceAttr(resourceName, "tracking_server_name", rName),
 					resource.TestCheckResourceAttr(resourceName, "automatic_model_registration", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "Active"),
 					resource.TestCheckResourceAttr(resourceName, "tracking_server_size", "Small"),
 					resource.TestCheckResourceAttrSet(resourceName, "tracking_server_url"),
 					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
@@ -113,6 +114,48 @@ func TestAccSageMakerMlflowTrackingServer_tags(t *testing.T) {
 	})
 }
 
+func TestAccSageMakerMlflowTrackingServer_desiredState(t *testing.T) {
+	ctx := acctest.Context(t)
+	var mpg sagemaker.DescribeMlflowTrackingServerOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_mlflow_tracking_server.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SageMakerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMlflowTrackingServerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMlflowTrackingServerConfig_desiredState(rName, "Inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMlflowTrackingServerExists(ctx, resourceName, &mpg),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "Inactive"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMlflowTrackingServerConfig_desiredState(rName, "Active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMlflowTrackingServerExists(ctx, resourceName, &mpg),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "Active"),
+				),
+			},
+			{
+				Config: testAccMlflowTrackingServerConfig_desiredState(rName, "Inactive"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMlflowTrackingServerExists(ctx, resourceName, &mpg),
+					resource.TestCheckResourceAttr(resourceName, "desired_state", "Inactive"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSageMakerMlflowTrackingServer_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var mpg sagemaker.DescribeMlflowTrackingServerOutput
@@ -259,6 +302,17 @@ resource "aws_sagemaker_mlflow_tracking_server" "test" {
 `, rName))
 }
 
+func testAccMlflowTrackingServerConfig_desiredState(rName, desiredState string) string {
+	return acctest.ConfigCompose(testAccMlflowTrackingServerConfig_base(rName), fmt.Sprintf(`
+resource "aws_sagemaker_mlflow_tracking_server" "test" {
+  tracking_server_name = %[1]q
+  role_arn             = aws_iam_role.test.arn
+  artifact_store_uri   = "s3://${aws_s3_bucket.test.bucket}/path"
+  desired_state        = %[2]q
+}
+`, rName, desiredState))
+}
+
 func testAccMlflowTrackingServerConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccMlflowTrackingServerConfig_base(rName), fmt.Sprintf(`
 resource "aws_sagemaker_mlflow_tracking_server" "test" {
@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package socialmessaging
+
+// Exports for use in tests only.
+var (
+	ResourceWhatsAppBusinessAccount = newWhatsAppBusinessAccountResource
+
+	FindWhatsAppBusinessAccountByID = findWhatsAppBusinessAccountByID
+)
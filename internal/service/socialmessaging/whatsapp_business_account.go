@@ -0,0 +1,388 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package socialmessaging
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/socialmessaging"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/socialmessaging/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_socialmessaging_whatsapp_business_account", name="WhatsApp Business Account")
+// @Tags(identifierAttribute="arn")
+func newWhatsAppBusinessAccountResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &whatsAppBusinessAccountResource{}
+
+	return r, nil
+}
+
+type whatsAppBusinessAccountResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*whatsAppBusinessAccountResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_socialmessaging_whatsapp_business_account"
+}
+
+func (r *whatsAppBusinessAccountResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"link_date": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"registration_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RegistrationStatus](),
+				Computed:   true,
+			},
+			"signup_access_token": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"waba_id": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"waba_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"event_destination": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[eventDestinationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"event_destination_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"phone_number": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[phoneNumberSetupModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"data_localization_region": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrID: schema.StringAttribute{
+							Required: true,
+						},
+						"two_factor_pin": schema.StringAttribute{
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *whatsAppBusinessAccountResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data whatsAppBusinessAccountResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SocialMessagingClient(ctx)
+
+	// Exchange the Meta embedded signup access token for the accounts that are waiting to be linked.
+	signupInput := socialmessaging.AssociateWhatsAppBusinessAccountInput{
+		SignupCallback: &awstypes.WhatsAppSignupCallback{
+			AccessToken: fwflex.StringFromFramework(ctx, data.SignupAccessToken),
+		},
+	}
+
+	signupOutput, err := conn.AssociateWhatsAppBusinessAccount(ctx, &signupInput)
+
+	if err != nil {
+		response.Diagnostics.AddError("associating End User Messaging Social WhatsApp Business Account", err.Error())
+
+		return
+	}
+
+	if signupOutput == nil || signupOutput.SignupCallbackResult == nil {
+		response.Diagnostics.AddError("associating End User Messaging Social WhatsApp Business Account", tfresource.NewEmptyResultError(signupInput).Error())
+
+		return
+	}
+
+	var ids []string
+	for id, v := range signupOutput.SignupCallbackResult.LinkedAccountsWithIncompleteSetup {
+		if data.WabaID.IsUnknown() || aws.ToString(v.WabaId) == data.WabaID.ValueString() {
+			ids = append(ids, id)
+		}
+	}
+
+	if n := len(ids); n != 1 {
+		if data.WabaID.IsUnknown() {
+			response.Diagnostics.AddError("associating End User Messaging Social WhatsApp Business Account", fmt.Sprintf("signup returned %d WhatsApp Business Accounts awaiting setup; set waba_id to choose one", n))
+		} else {
+			response.Diagnostics.AddError("associating End User Messaging Social WhatsApp Business Account", fmt.Sprintf("signup did not return WhatsApp Business Account %s awaiting setup", data.WabaID.ValueString()))
+		}
+
+		return
+	}
+	id := ids[0]
+
+	var phoneNumbers []awstypes.WabaPhoneNumberSetupFinalization
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.PhoneNumbers, &phoneNumbers)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var eventDestinations []awstypes.WhatsAppBusinessAccountEventDestination
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.EventDestinations, &eventDestinations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := socialmessaging.AssociateWhatsAppBusinessAccountInput{
+		SetupFinalization: &awstypes.WhatsAppSetupFinalization{
+			AssociateInProgressToken: signupOutput.SignupCallbackResult.AssociateInProgressToken,
+			PhoneNumbers:             phoneNumbers,
+			Waba: &awstypes.WabaSetupFinalization{
+				EventDestinations: eventDestinations,
+				Id:                aws.String(id),
+				Tags:              getTagsIn(ctx),
+			},
+		},
+	}
+
+	_, err = conn.AssociateWhatsAppBusinessAccount(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("completing End User Messaging Social WhatsApp Business Account (%s) setup", id), err.Error())
+
+		return
+	}
+
+	account, err := findWhatsAppBusinessAccountByID(ctx, conn, id)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging Social WhatsApp Business Account (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+	response.Diagnostics.Append(data.flatten(ctx, account)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *whatsAppBusinessAccountResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data whatsAppBusinessAccountResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SocialMessagingClient(ctx)
+
+	account, err := findWhatsAppBusinessAccountByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading End User Messaging Social WhatsApp Business Account (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, account)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *whatsAppBusinessAccountResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new whatsAppBusinessAccountResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SocialMessagingClient(ctx)
+
+	if !new.EventDestinations.Equal(old.EventDestinations) {
+		// An empty list removes all event destinations.
+		eventDestinations := []awstypes.WhatsAppBusinessAccountEventDestination{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.EventDestinations, &eventDestinations)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := socialmessaging.PutWhatsAppBusinessAccountEventDestinationsInput{
+			EventDestinations: eventDestinations,
+			Id:                fwflex.StringFromFramework(ctx, new.ID),
+		}
+
+		_, err := conn.PutWhatsAppBusinessAccountEventDestinations(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating End User Messaging Social WhatsApp Business Account (%s) event destinations", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *whatsAppBusinessAccountResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data whatsAppBusinessAccountResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().SocialMessagingClient(ctx)
+
+	_, err := conn.DisassociateWhatsAppBusinessAccount(ctx, &socialmessaging.DisassociateWhatsAppBusinessAccountInput{
+		Id: data.ID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("disassociating End User Messaging Social WhatsApp Business Account (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *whatsAppBusinessAccountResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findWhatsAppBusinessAccountByID(ctx context.Context, conn *socialmessaging.Client, id string) (*awstypes.LinkedWhatsAppBusinessAccount, error) {
+	input := &socialmessaging.GetLinkedWhatsAppBusinessAccountInput{
+		Id: aws.String(id),
+	}
+
+	output, err := conn.GetLinkedWhatsAppBusinessAccount(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Account == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Account, nil
+}
+
+type whatsAppBusinessAccountResourceModel struct {
+	ARN                types.String                                           `tfsdk:"arn"`
+	EventDestinations  fwtypes.ListNestedObjectValueOf[eventDestinationModel] `tfsdk:"event_destination"`
+	ID                 types.String                                           `tfsdk:"id"`
+	LinkDate           timetypes.RFC3339                                      `tfsdk:"link_date"`
+	PhoneNumbers       fwtypes.ListNestedObjectValueOf[phoneNumberSetupModel] `tfsdk:"phone_number"`
+	RegistrationStatus fwtypes.StringEnum[awstypes.RegistrationStatus]        `tfsdk:"registration_status"`
+	SignupAccessToken  types.String                                           `tfsdk:"signup_access_token"`
+	Tags               tftags.Map                                             `tfsdk:"tags"`
+	TagsAll            tftags.Map                                             `tfsdk:"tags_all"`
+	WabaID             types.String                                           `tfsdk:"waba_id"`
+	WabaName           types.String                                           `tfsdk:"waba_name"`
+}
+
+// flatten sets the attributes that can be read back from the linked account.
+// The signup access token and phone number PINs are write-only and are left as configured.
+func (model *whatsAppBusinessAccountResourceModel) flatten(ctx context.Context, account *awstypes.LinkedWhatsAppBusinessAccount) diag.Diagnostics {
+	model.ARN = fwflex.StringToFramework(ctx, account.Arn)
+	model.LinkDate = timetypes.NewRFC3339TimePointerValue(account.LinkDate)
+	model.RegistrationStatus = fwtypes.StringEnumValue(account.RegistrationStatus)
+	model.WabaID = fwflex.StringToFramework(ctx, account.WabaId)
+	model.WabaName = fwflex.StringToFramework(ctx, account.WabaName)
+
+	return fwflex.Flatten(ctx, account.EventDestinations, &model.EventDestinations)
+}
+
+type eventDestinationModel struct {
+	EventDestinationARN fwtypes.ARN `tfsdk:"event_destination_arn"`
+}
+
+type phoneNumberSetupModel struct {
+	DataLocalizationRegion types.String `tfsdk:"data_localization_region"`
+	ID                     types.String `tfsdk:"id"`
+	TwoFactorPin           types.String `tfsdk:"two_factor_pin"`
+}
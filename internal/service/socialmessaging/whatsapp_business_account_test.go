@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package socialmessaging_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/socialmessaging"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/socialmessaging/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsocialmessaging "github.com/hashicorp/terraform-provider-aws/internal/service/socialmessaging"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The signup access token comes from the Meta embedded signup flow and can only be used once,
+// so these tests are not run in parallel and need fresh values for every run.
+func TestAccSocialMessagingWhatsAppBusinessAccount_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	signupAccessToken := acctest.SkipIfEnvVarNotSet(t, "SOCIALMESSAGING_SIGNUP_ACCESS_TOKEN")
+	phoneNumberID := acctest.SkipIfEnvVarNotSet(t, "SOCIALMESSAGING_PHONE_NUMBER_ID")
+	twoFactorPin := acctest.SkipIfEnvVarNotSet(t, "SOCIALMESSAGING_TWO_FACTOR_PIN")
+	var account awstypes.LinkedWhatsAppBusinessAccount
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_socialmessaging_whatsapp_business_account.test"
+	topicResourceName := "aws_sns_topic.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SocialMessaging)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SocialMessagingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWhatsAppBusinessAccountDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWhatsAppBusinessAccountConfig_basic(signupAccessToken, phoneNumberID, twoFactorPin),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWhatsAppBusinessAccountExists(ctx, resourceName, &account),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "social-messaging", regexache.MustCompile(`waba/.+$`)),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "link_date"),
+					resource.TestCheckResourceAttr(resourceName, "phone_number.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "phone_number.0.id", phoneNumberID),
+					resource.TestCheckResourceAttrSet(resourceName, "registration_status"),
+					resource.TestCheckResourceAttrSet(resourceName, "waba_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "waba_name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"phone_number", "signup_access_token"},
+			},
+			{
+				Config: testAccWhatsAppBusinessAccountConfig_eventDestination(rName, signupAccessToken, phoneNumberID, twoFactorPin),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWhatsAppBusinessAccountExists(ctx, resourceName, &account),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "event_destination.0.event_destination_arn", topicResourceName, names.AttrARN),
+				),
+			},
+			{
+				Config: testAccWhatsAppBusinessAccountConfig_basic(signupAccessToken, phoneNumberID, twoFactorPin),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWhatsAppBusinessAccountExists(ctx, resourceName, &account),
+					resource.TestCheckResourceAttr(resourceName, "event_destination.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWhatsAppBusinessAccountDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SocialMessagingClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_socialmessaging_whatsapp_business_account" {
+				continue
+			}
+
+			_, err := tfsocialmessaging.FindWhatsAppBusinessAccountByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("End User Messaging Social WhatsApp Business Account %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWhatsAppBusinessAccountExists(ctx context.Context, n string, v *awstypes.LinkedWhatsAppBusinessAccount) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SocialMessagingClient(ctx)
+
+		output, err := tfsocialmessaging.FindWhatsAppBusinessAccountByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SocialMessagingClient(ctx)
+
+	input := &socialmessaging.ListLinkedWhatsAppBusinessAccountsInput{}
+	_, err := conn.ListLinkedWhatsAppBusinessAccounts(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccWhatsAppBusinessAccountConfig_basic(signupAccessToken, phoneNumberID, twoFactorPin string) string {
+	return fmt.Sprintf(`
+resource "aws_socialmessaging_whatsapp_business_account" "test" {
+  signup_access_token = %[1]q
+
+  phone_number {
+    id             = %[2]q
+    two_factor_pin = %[3]q
+  }
+}
+`, signupAccessToken, phoneNumberID, twoFactorPin)
+}
+
+func testAccWhatsAppBusinessAccountConfig_eventDestination(rName, signupAccessToken, phoneNumberID, twoFactorPin string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_socialmessaging_whatsapp_business_account" "test" {
+  signup_access_token = %[2]q
+
+  phone_number {
+    id             = %[3]q
+    two_factor_pin = %[4]q
+  }
+
+  event_destination {
+    event_destination_arn = aws_sns_topic.test.arn
+  }
+}
+`, rName, signupAccessToken, phoneNumberID, twoFactorPin)
+}
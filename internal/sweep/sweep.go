@@ -122,4 +122,30 @@ func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tf
 	return g.Wait().ErrorOrNil()
 }
 
+// SweepOrchestratorWithConcurrency is like SweepOrchestrator but deletes at most limit resources at a time.
+// Errors from individual deletes are aggregated and returned once every resource has been attempted.
+func SweepOrchestratorWithConcurrency(ctx context.Context, sweepables []Sweepable, limit int, optFns ...tfresource.OptionsFunc) error {
+	if limit < 1 {
+		return SweepOrchestrator(ctx, sweepables, optFns...)
+	}
+
+	if len(sweepables) == 0 {
+		tflog.Info(ctx, "No resources to sweep")
+	}
+
+	var g multierror.Group
+	sem := make(chan struct{}, limit)
+
+	for _, sweepable := range sweepables {
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
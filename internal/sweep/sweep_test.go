@@ -5,10 +5,16 @@ package sweep_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func TestMain(m *testing.M) {
@@ -20,3 +26,82 @@ func TestMain(m *testing.M) {
 
 	resource.TestMain(m)
 }
+
+type testSweepable struct {
+	err      error
+	inFlight *atomic.Int32
+	maxSeen  *atomic.Int32
+	deleted  *sync.Map
+	id       int
+}
+
+func (s testSweepable) Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error {
+	n := s.inFlight.Add(1)
+	defer s.inFlight.Add(-1)
+
+	for {
+		v := s.maxSeen.Load()
+		if n <= v || s.maxSeen.CompareAndSwap(v, n) {
+			break
+		}
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	s.deleted.Store(s.id, true)
+
+	return s.err
+}
+
+func TestSweepOrchestratorWithConcurrency(t *testing.T) {
+	t.Parallel()
+
+	const (
+		limit = 3
+		total = 10
+	)
+
+	var inFlight, maxSeen atomic.Int32
+	var deleted sync.Map
+	errFailed := errors.New("failed")
+	sweepables := make([]sweep.Sweepable, 0, total)
+
+	for i := range total {
+		var err error
+		if i%4 == 0 {
+			err = fmt.Errorf("deleting %d: %w", i, errFailed)
+		}
+
+		sweepables = append(sweepables, testSweepable{
+			err:      err,
+			inFlight: &inFlight,
+			maxSeen:  &maxSeen,
+			deleted:  &deleted,
+			id:       i,
+		})
+	}
+
+	err := sweep.SweepOrchestratorWithConcurrency(context.Background(), sweepables, limit)
+
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected aggregated delete errors, got: %v", err)
+	}
+
+	for i := range total {
+		if _, ok := deleted.Load(i); !ok {
+			t.Errorf("sweepable %d was not deleted", i)
+		}
+	}
+
+	if got := maxSeen.Load(); got > limit {
+		t.Errorf("%d concurrent deletes, want at most %d", got, limit)
+	}
+
+	var merr interface{ WrappedErrors() []error }
+	if errors.As(err, &merr) {
+		if got, want := len(merr.WrappedErrors()), 3; got != want {
+			t.Errorf("%d errors collected, want %d", got, want)
+		}
+	} else {
+		t.Errorf("expected a multierror, got %T", err)
+	}
+}
@@ -5,6 +5,7 @@ package tags
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"reflect"
@@ -49,9 +50,17 @@ const (
 	IgnoreTagsKeyPrefixesEnvVar = "TF_AWS_IGNORE_TAGS_KEY_PREFIXES"
 )
 
-// DefaultConfig contains tags to default across all resources.
+// DefaultConfig contains tags to default across all resources
+// and the tag policy that resources' tags must satisfy.
 type DefaultConfig struct {
 	Tags KeyValueTags
+
+	// RequiredTags are tags every resource must have.
+	// A required tag with an empty value may have any value.
+	RequiredTags KeyValueTags
+
+	// ForbiddenTagKeys are tag keys no resource may have.
+	ForbiddenTagKeys KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -105,6 +114,43 @@ func (dc *DefaultConfig) TagsEqual(tags KeyValueTags) bool {
 	return dc.Tags.ContainsAll(tags)
 }
 
+// ValidateTags returns an error for each of the given tags that violates
+// the configuration's required tags or forbidden tag keys.
+// Tags should include those merged from the provider-level defaults.
+func (dc *DefaultConfig) ValidateTags(tags KeyValueTags) error {
+	if dc == nil {
+		return nil
+	}
+
+	var errs []error
+
+	required := dc.RequiredTags.Keys()
+	slices.Sort(required)
+	for _, k := range required {
+		v, ok := tags[k]
+		if !ok {
+			errs = append(errs, fmt.Errorf("missing required tag %q", k))
+			continue
+		}
+
+		if want := dc.RequiredTags.KeyValue(k); want != nil && *want != "" {
+			if got := v.ValueString(); got != *want {
+				errs = append(errs, fmt.Errorf("tag %q must have value %q, got %q", k, *want, got))
+			}
+		}
+	}
+
+	forbidden := dc.ForbiddenTagKeys.Keys()
+	slices.Sort(forbidden)
+	for _, k := range forbidden {
+		if _, ok := tags[k]; ok {
+			errs = append(errs, fmt.Errorf("tag %q is forbidden", k))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 // IgnoreAWS returns non-AWS tag keys.
 func (tags KeyValueTags) IgnoreAWS() KeyValueTags { // nosemgrep:ci.aws-in-func-name
 	result := make(KeyValueTags)
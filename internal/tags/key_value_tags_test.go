@@ -314,6 +314,97 @@ func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigValidateTags(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &DefaultConfig{
+		RequiredTags: New(ctx, map[string]string{
+			"CostCenter":  "",
+			"Environment": "production",
+		}),
+		ForbiddenTagKeys: New(ctx, []string{"Temporary"}),
+	}
+	testCases := []struct {
+		name          string
+		tags          KeyValueTags
+		defaultConfig *DefaultConfig
+		want          string
+	}{
+		{
+			name: "no config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: nil,
+		},
+		{
+			name: "empty config",
+			tags: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+			defaultConfig: &DefaultConfig{},
+		},
+		{
+			name: "compliant",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "production",
+				"key1":        "value1",
+			}),
+			defaultConfig: policy,
+		},
+		{
+			name:          "no tags",
+			tags:          nil,
+			defaultConfig: policy,
+			want:          "missing required tag \"CostCenter\"\nmissing required tag \"Environment\"",
+		},
+		{
+			name: "wrong enforced value",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "staging",
+			}),
+			defaultConfig: policy,
+			want:          "tag \"Environment\" must have value \"production\", got \"staging\"",
+		},
+		{
+			name: "forbidden key",
+			tags: New(ctx, map[string]string{
+				"CostCenter":  "1234",
+				"Environment": "production",
+				"Temporary":   "",
+			}),
+			defaultConfig: policy,
+			want:          "tag \"Temporary\" is forbidden",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.defaultConfig.ValidateTags(testCase.tags)
+
+			if testCase.want == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.want)
+			}
+
+			if got := err.Error(); got != testCase.want {
+				t.Errorf("got error %q; want %q", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreAWS(t *testing.T) { // nosemgrep:ci.aws-in-func-name
 	t.Parallel()
 
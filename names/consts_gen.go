@@ -25,6 +25,7 @@ const (
 	AuditManager                 = "auditmanager"
 	AutoScaling                  = "autoscaling"
 	AutoScalingPlans             = "autoscalingplans"
+	B2BI                         = "b2bi"
 	BCMDataExports               = "bcmdataexports"
 	Backup                       = "backup"
 	Batch                        = "batch"
@@ -231,6 +232,7 @@ const (
 	Shield                       = "shield"
 	Signer                       = "signer"
 	SimpleDB                     = "simpledb"
+	SocialMessaging              = "socialmessaging"
 	StorageGateway               = "storagegateway"
 	Synthetics                   = "synthetics"
 	TaxSettings                  = "taxsettings"
@@ -276,6 +278,7 @@ const (
 	AuditManagerServiceID                 = "AuditManager"
 	AutoScalingServiceID                  = "Auto Scaling"
 	AutoScalingPlansServiceID             = "Auto Scaling Plans"
+	B2BIServiceID                         = "b2bi"
 	BCMDataExportsServiceID               = "BCM Data Exports"
 	BackupServiceID                       = "Backup"
 	BatchServiceID                        = "Batch"
@@ -482,6 +485,7 @@ const (
 	ShieldServiceID                       = "Shield"
 	SignerServiceID                       = "signer"
 	SimpleDBServiceID                     = "SimpleDB"
+	SocialMessagingServiceID              = "SocialMessaging"
 	StorageGatewayServiceID               = "Storage Gateway"
 	SyntheticsServiceID                   = "synthetics"
 	TaxSettingsServiceID                  = "TaxSettings"
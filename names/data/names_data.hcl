@@ -766,6 +766,29 @@ service "autoscalingplans" {
   doc_prefix               = ["autoscalingplans_"]
 }
 
+service "b2bi" {
+  sdk {
+    id = "b2bi"
+  }
+
+  names {
+    provider_name_upper = "B2BI"
+    human_friendly      = "B2B Data Interchange"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListProfiles"
+  }
+
+  resource_prefix {
+    correct = "aws_b2bi_"
+  }
+
+  provider_package_correct = "b2bi"
+  doc_prefix               = ["b2bi_"]
+  brand                    = "AWS"
+}
+
 service "backup" {
   sdk {
     id = "Backup"
@@ -8222,6 +8245,29 @@ service "sns" {
   brand                    = "AWS"
 }
 
+service "socialmessaging" {
+  sdk {
+    id = "SocialMessaging"
+  }
+
+  names {
+    provider_name_upper = "SocialMessaging"
+    human_friendly      = "End User Messaging Social"
+  }
+
+  endpoint_info {
+    endpoint_api_call = "ListLinkedWhatsAppBusinessAccounts"
+  }
+
+  resource_prefix {
+    correct = "aws_socialmessaging_"
+  }
+
+  provider_package_correct = "socialmessaging"
+  doc_prefix               = ["socialmessaging_"]
+  brand                    = "AWS"
+}
+
 service "sqs" {
   sdk {
     id = "SQS"